@@ -0,0 +1,129 @@
+// Command alertmanager-bridge receives Prometheus Alertmanager webhook
+// notifications and forwards each alert to an Incident.io HTTP alert source.
+//
+// Usage:
+//
+//	alertmanager-bridge -source-config-id 01H... -token ... \
+//	    -metadata 'host={{ .Labels.instance | trimSuffix ":9100" }}'
+//
+// Point an Alertmanager webhook receiver at http://<listen>/webhook.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/cpanato/go-incident-io/incidentio"
+	"github.com/cpanato/go-incident-io/incidentio/alertmanager"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := run(ctx, os.Args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "alertmanager-bridge: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string) error {
+	cfg := alertmanager.Config{MetadataTemplates: map[string]string{}}
+
+	fs := flag.NewFlagSet("alertmanager-bridge", flag.ContinueOnError)
+	listen := fs.String("listen", ":9095", "address to listen on")
+	apiKey := fs.String("api-key", os.Getenv("INCIDENTIO_API_KEY"), "Incident.io API key, defaults to $INCIDENTIO_API_KEY")
+	baseURL := fs.String("base-url", "", "Incident.io API base URL")
+	fs.StringVar(&cfg.SourceConfigID, "source-config-id", "", "config ID of the HTTP alert source")
+	fs.StringVar(&cfg.Token, "token", os.Getenv("INCIDENTIO_ALERT_SOURCE_TOKEN"), "alert source token, defaults to $INCIDENTIO_ALERT_SOURCE_TOKEN")
+	fs.StringVar(&cfg.TitleTemplate, "title-template", "", "template for the alert title")
+	fs.StringVar(&cfg.DescriptionTemplate, "description-template", "", "template for the alert description")
+	fs.Func("metadata", "metadata key=template, may be repeated", func(s string) error {
+		key, tmpl, ok := strings.Cut(s, "=")
+		if !ok || key == "" {
+			return fmt.Errorf("want key=template, got %q", s)
+		}
+		cfg.MetadataTemplates[key] = tmpl
+		return nil
+	})
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *apiKey == "" && cfg.Token == "" {
+		return errors.New("an API key or alert source token is required, set -api-key or -token")
+	}
+
+	var opts []incidentio.ClientOption
+	if *baseURL != "" {
+		opts = append(opts, incidentio.WithBaseURL(*baseURL))
+	}
+
+	bridge, err := alertmanager.New(incidentio.NewClient(*apiKey, opts...), cfg)
+	if err != nil {
+		return err
+	}
+
+	srv := &http.Server{
+		Addr:              *listen,
+		Handler:           newMux(bridge),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errc := make(chan error, 1)
+	go func() {
+		log.Printf("listening on %s", *listen)
+		errc <- srv.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errc:
+		return err
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	return srv.Shutdown(shutdownCtx)
+}
+
+func newMux(bridge http.Handler) *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.Handle("/webhook", logRequests(bridge))
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	return mux
+}
+
+// logRequests logs notifications that could not be forwarded.
+func logRequests(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+		if rec.status >= http.StatusBadRequest {
+			log.Printf("%s %s: %d", r.Method, r.URL.Path, rec.status)
+		}
+	})
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRun_InvalidFlags(t *testing.T) {
+	t.Setenv("INCIDENTIO_API_KEY", "")
+	t.Setenv("INCIDENTIO_ALERT_SOURCE_TOKEN", "")
+
+	tests := map[string][]string{
+		"no credentials":   {"-source-config-id", "source-1"},
+		"no source config": {"-api-key", "key"},
+		"bad metadata":     {"-api-key", "key", "-source-config-id", "source-1", "-metadata", "no-template"},
+		"bad template":     {"-api-key", "key", "-source-config-id", "source-1", "-title-template", "{{ .Labels"},
+	}
+
+	for name, args := range tests {
+		t.Run(name, func(t *testing.T) {
+			if err := run(context.Background(), args); err == nil {
+				t.Errorf("run(%q) returned no error", args)
+			}
+		})
+	}
+}
+
+func TestNewMux(t *testing.T) {
+	var called bool
+	mux := newMux(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest("GET", "/healthz", nil))
+	if w.Code != http.StatusOK {
+		t.Errorf("/healthz returned %d, want %d", w.Code, http.StatusOK)
+	}
+
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest("POST", "/webhook", nil))
+	if !called {
+		t.Error("/webhook did not reach the bridge")
+	}
+	if w.Code != http.StatusBadGateway {
+		t.Errorf("/webhook returned %d, want the bridge status %d", w.Code, http.StatusBadGateway)
+	}
+}
@@ -0,0 +1,108 @@
+// Command incidentio-webhook-sim posts realistic, correctly signed Incident.io
+// webhook deliveries to a local endpoint, so webhook consumers can be tested
+// without triggering real incidents.
+//
+// Usage:
+//
+//	incidentio-webhook-sim -url http://localhost:8080/webhooks -secret whsec_... -scenario all
+//
+// A fixture file may hold either a complete delivery body (with an
+// "event_type" key), which is sent as-is, or an incident, which is used as
+// the starting point of the scenario.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cpanato/go-incident-io/incidentio"
+)
+
+func main() {
+	if err := run(context.Background(), os.Args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "incidentio-webhook-sim: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("incidentio-webhook-sim", flag.ContinueOnError)
+	url := fs.String("url", "http://localhost:8080/webhooks", "webhook endpoint to post deliveries to")
+	secret := fs.String("secret", os.Getenv("INCIDENTIO_WEBHOOK_SECRET"), "webhook signing secret (whsec_...), defaults to $INCIDENTIO_WEBHOOK_SECRET")
+	scenario := fs.String("scenario", scenarioAll, "scenario to play: created, status-changed, severity-escalated, closed or all")
+	fixture := fs.String("fixture", "", "JSON file holding a delivery body or an incident")
+	interval := fs.Duration("interval", time.Second, "pause between deliveries")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *secret == "" {
+		return errors.New("a signing secret is required, set -secret or $INCIDENTIO_WEBHOOK_SECRET")
+	}
+
+	bodies, err := loadDeliveries(*fixture, *scenario, time.Now())
+	if err != nil {
+		return err
+	}
+
+	s := &sender{
+		client: &http.Client{Timeout: 30 * time.Second},
+		url:    *url,
+		secret: *secret,
+		now:    time.Now,
+	}
+
+	for i, body := range bodies {
+		if i > 0 {
+			time.Sleep(*interval)
+		}
+
+		id, err := s.send(ctx, body)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "delivered %s (%s)\n", id, eventType(body))
+	}
+
+	return nil
+}
+
+// loadDeliveries returns the delivery bodies to send, built from fixture if
+// one is given and from the default incident otherwise.
+func loadDeliveries(fixture, scenario string, now time.Time) ([][]byte, error) {
+	if fixture == "" {
+		return buildDeliveries(defaultIncident(now), scenario, now)
+	}
+
+	data, err := os.ReadFile(filepath.Clean(fixture))
+	if err != nil {
+		return nil, err
+	}
+
+	if eventType(data) != "" {
+		return [][]byte{data}, nil
+	}
+
+	incident := &incidentio.Incident{}
+	if err := json.Unmarshal(data, incident); err != nil {
+		return nil, fmt.Errorf("%s: %w", fixture, err)
+	}
+
+	return buildDeliveries(incident, scenario, now)
+}
+
+func eventType(body []byte) string {
+	var envelope struct {
+		EventType string `json:"event_type"`
+	}
+	_ = json.Unmarshal(body, &envelope)
+
+	return envelope.EventType
+}
@@ -0,0 +1,211 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cpanato/go-incident-io/incidentio"
+	"github.com/cpanato/go-incident-io/incidentio/webhooks"
+)
+
+// Scenarios the simulator can play against an incident.
+const (
+	scenarioCreated           = "created"
+	scenarioStatusChanged     = "status-changed"
+	scenarioSeverityEscalated = "severity-escalated"
+	scenarioClosed            = "closed"
+	scenarioAll               = "all"
+)
+
+// severities are the severity levels used when escalating an incident.
+var severities = []*incidentio.Severity{
+	{ID: "01SIMSEVERITYMINOR00000000", Name: "Minor", Rank: 1},
+	{ID: "01SIMSEVERITYMAJOR00000000", Name: "Major", Rank: 2},
+	{ID: "01SIMSEVERITYCRITICAL00000", Name: "Critical", Rank: 3},
+}
+
+// statuses are the incident statuses the simulated incident moves through.
+var statuses = []*incidentio.IncidentStatus{
+	{ID: "01SIMSTATUSTRIAGE000000000", Name: "Triage", Category: incidentio.IncidentStatusCategoryTriage, Rank: 1},
+	{ID: "01SIMSTATUSINVESTIGATING00", Name: "Investigating", Category: incidentio.IncidentStatusCategoryLive, Rank: 2},
+	{ID: "01SIMSTATUSCLOSED000000000", Name: "Closed", Category: incidentio.IncidentStatusCategoryClosed, Rank: 3},
+}
+
+// defaultIncident returns the incident used when no fixture is given.
+func defaultIncident(now time.Time) *incidentio.Incident {
+	return &incidentio.Incident{
+		ID:             "01SIMINCIDENT0000000000001",
+		Name:           "Simulated: elevated API error rate",
+		Summary:        "Generated by incidentio-webhook-sim",
+		Type:           "incident",
+		Status:         statuses[0].Category,
+		IncidentStatus: statuses[0],
+		Severity:       severities[0],
+		Mode:           "test",
+		Visibility:     "public",
+		CreatedAt:      incidentio.Timestamp{Time: now},
+		UpdatedAt:      incidentio.Timestamp{Time: now},
+	}
+}
+
+// buildDeliveries returns the delivery bodies for scenario, played in order
+// against incident. The incident is updated to its final state.
+func buildDeliveries(incident *incidentio.Incident, scenario string, now time.Time) ([][]byte, error) {
+	steps := []string{scenario}
+	if scenario == scenarioAll {
+		steps = []string{scenarioCreated, scenarioStatusChanged, scenarioSeverityEscalated, scenarioClosed}
+	}
+
+	bodies := make([][]byte, 0, len(steps))
+	for _, step := range steps {
+		body, err := buildDelivery(incident, step, now)
+		if err != nil {
+			return nil, err
+		}
+		bodies = append(bodies, body)
+	}
+
+	return bodies, nil
+}
+
+func buildDelivery(incident *incidentio.Incident, scenario string, now time.Time) ([]byte, error) {
+	incident.UpdatedAt = incidentio.Timestamp{Time: now}
+
+	switch scenario {
+	case scenarioCreated:
+		return envelope(webhooks.EventTypeIncidentCreated, incident)
+
+	case scenarioStatusChanged:
+		return statusChange(incident, incidentio.IncidentStatusCategoryLive)
+
+	case scenarioSeverityEscalated:
+		next, err := escalate(incident.Severity)
+		if err != nil {
+			return nil, err
+		}
+		incident.Severity = next
+		return envelope(webhooks.EventTypeIncidentUpdated, incident)
+
+	case scenarioClosed:
+		incident.ClosedAt = &incidentio.Timestamp{Time: now}
+		return statusChange(incident, incidentio.IncidentStatusCategoryClosed)
+
+	default:
+		return nil, fmt.Errorf("unknown scenario %q", scenario)
+	}
+}
+
+// statusChange moves incident to the simulated status of category. Incident
+// fixtures without an incident status are matched by their legacy status.
+func statusChange(incident *incidentio.Incident, category string) ([]byte, error) {
+	previous := incident.IncidentStatus
+	if previous == nil {
+		previous = statusOf(incident.Status)
+	}
+	next := statusOf(category)
+
+	incident.Status = next.Category
+	incident.IncidentStatus = next
+
+	return envelope(webhooks.EventTypeIncidentStatusUpdated, &webhooks.IncidentStatusUpdatedEvent{
+		Incident:       incident,
+		NewStatus:      next,
+		PreviousStatus: previous,
+	})
+}
+
+// statusOf returns the simulated status of category, or a status named after
+// the category when the simulator has none.
+func statusOf(category string) *incidentio.IncidentStatus {
+	for _, s := range statuses {
+		if s.Category == category {
+			return s
+		}
+	}
+
+	return &incidentio.IncidentStatus{Name: category, Category: category}
+}
+
+// escalate returns the severity ranked directly above current.
+func escalate(current *incidentio.Severity) (*incidentio.Severity, error) {
+	rank := 0
+	if current != nil {
+		rank = current.Rank
+	}
+
+	for _, s := range severities {
+		if s.Rank > rank {
+			return s, nil
+		}
+	}
+
+	return nil, fmt.Errorf("incident is already at the highest severity")
+}
+
+// envelope wraps payload the way Incident.io does, keyed by the event type.
+func envelope(eventType string, payload interface{}) ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"event_type": eventType,
+		eventType:    payload,
+	})
+}
+
+// sender posts signed deliveries to a webhook endpoint.
+type sender struct {
+	client *http.Client
+	url    string
+	secret string
+	now    func() time.Time
+}
+
+func (s *sender) send(ctx context.Context, body []byte) (string, error) {
+	id, err := deliveryID()
+	if err != nil {
+		return "", err
+	}
+
+	timestamp := s.now()
+	signature, err := webhooks.Sign(s.secret, id, timestamp, body)
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhooks.HeaderID, id)
+	req.Header.Set(webhooks.HeaderTimestamp, strconv.FormatInt(timestamp.Unix(), 10))
+	req.Header.Set(webhooks.HeaderSignature, signature)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return id, err
+	}
+	defer resp.Body.Close() //nolint: errcheck
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return id, fmt.Errorf("%s responded %d: %s", s.url, resp.StatusCode, bytes.TrimSpace(msg))
+	}
+
+	return id, nil
+}
+
+func deliveryID() (string, error) {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return "msg_" + hex.EncodeToString(b), nil
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cpanato/go-incident-io/incidentio/webhooks"
+)
+
+const testSecret = "whsec_MfKQ9r8GKYqrTwjUPD8ILPZIo2LaLaSw"
+
+func newTestReceiver(t *testing.T, events *[]string) *httptest.Server {
+	t.Helper()
+
+	router := webhooks.NewRouter()
+	router.OnIncidentCreated(func(_ context.Context, e *webhooks.IncidentCreatedEvent) error {
+		*events = append(*events, "created:"+e.Incident.Status)
+		return nil
+	})
+	router.OnIncidentStatusUpdated(func(_ context.Context, e *webhooks.IncidentStatusUpdatedEvent) error {
+		*events = append(*events, "status:"+e.PreviousStatus.Category+"->"+e.NewStatus.Category)
+		return nil
+	})
+	router.OnIncidentUpdated(func(_ context.Context, e *webhooks.IncidentUpdatedEvent) error {
+		*events = append(*events, "updated:"+e.Incident.Severity.Name)
+		return nil
+	})
+
+	h, err := webhooks.NewHandler([]string{testSecret}, router)
+	if err != nil {
+		t.Fatalf("NewHandler() error = %v", err)
+	}
+
+	server := httptest.NewServer(h)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestRun_AllScenarios(t *testing.T) {
+	var events []string
+	server := newTestReceiver(t, &events)
+
+	err := run(context.Background(), []string{"-url", server.URL, "-secret", testSecret, "-interval", "0"})
+	if err != nil {
+		t.Fatalf("run() error = %v", err)
+	}
+
+	want := []string{
+		"created:triage",
+		"status:triage->live",
+		"updated:Major",
+		"status:live->closed",
+	}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("received %v, want %v", events, want)
+	}
+}
+
+func TestRun_WrongSecret(t *testing.T) {
+	var events []string
+	server := newTestReceiver(t, &events)
+
+	err := run(context.Background(), []string{"-url", server.URL, "-secret", "whsec_dGhlLW9sZC1zZWNyZXQtdmFsdWU=", "-scenario", scenarioCreated})
+	if err == nil {
+		t.Error("run() error = nil, want rejection")
+	}
+}
+
+func TestLoadDeliveries_Fixtures(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Unix(1700000000, 0)
+
+	delivery := filepath.Join(dir, "delivery.json")
+	raw := `{"event_type":"public_incident.incident_updated_v2","public_incident.incident_updated_v2":{"id":"01FDAG4SAP5TYPT98WGR2N7W91"}}`
+	if err := os.WriteFile(delivery, []byte(raw), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	bodies, err := loadDeliveries(delivery, scenarioAll, now)
+	if err != nil {
+		t.Fatalf("loadDeliveries() error = %v", err)
+	}
+	if len(bodies) != 1 || string(bodies[0]) != raw {
+		t.Errorf("loadDeliveries() = %s, want the fixture unchanged", bodies)
+	}
+
+	incident := filepath.Join(dir, "incident.json")
+	if err := os.WriteFile(incident, []byte(`{"id":"01FDAG4SAP5TYPT98WGR2N7W91","name":"Fixture","status":"live","severity":{"id":"sev","name":"Critical","rank":3},"created_at":"2021-08-17T13:28:57Z","updated_at":"2021-08-17T13:28:57Z"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := loadDeliveries(incident, scenarioSeverityEscalated, now); err == nil {
+		t.Error("loadDeliveries() escalating a critical incident returned nil error")
+	}
+
+	bodies, err = loadDeliveries(incident, scenarioClosed, now)
+	if err != nil {
+		t.Fatalf("loadDeliveries() error = %v", err)
+	}
+	if got := eventType(bodies[0]); got != webhooks.EventTypeIncidentStatusUpdated {
+		t.Errorf("loadDeliveries() event type = %q, want %q", got, webhooks.EventTypeIncidentStatusUpdated)
+	}
+}
@@ -1,5 +1,19 @@
 package incidentio
 
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Action statuses.
+const (
+	ActionStatusOutstanding = "outstanding"
+	ActionStatusCompleted   = "completed"
+	ActionStatusDeleted     = "deleted"
+	ActionStatusNotDoing    = "not_doing"
+)
+
 // ActionsService handles communication with the actions related methods.
 type ActionsService struct {
 	client *Client
@@ -16,3 +30,102 @@ type Action struct {
 	CreatedAt   Timestamp  `json:"created_at"`
 	UpdatedAt   Timestamp  `json:"updated_at"`
 }
+
+// ListActionsOptions represents options for listing actions.
+type ListActionsOptions struct {
+	// IncidentID restricts the results to actions of a single incident.
+	IncidentID string `url:"incident_id,omitempty"`
+	// IncidentMode restricts the results to incidents of the given mode,
+	// e.g. "standard", "retrospective", "test" or "tutorial".
+	IncidentMode string `url:"incident_mode,omitempty"`
+	ListOptions
+}
+
+// UpdateActionOptions represents options for updating an action.
+type UpdateActionOptions struct {
+	Status     *string `json:"status,omitempty"`
+	AssigneeID *string `json:"assignee_id,omitempty"`
+}
+
+// List returns a single page of actions.
+func (s *ActionsService) List(ctx context.Context, opts *ListActionsOptions) ([]*Action, *http.Response, error) {
+	actions, _, resp, err := s.list(ctx, opts)
+	return actions, resp, err
+}
+
+// ListAll returns all actions matching opts, following pagination until
+// every page has been fetched.
+func (s *ActionsService) ListAll(ctx context.Context, opts *ListActionsOptions) ([]*Action, *http.Response, error) {
+	pageOpts := ListActionsOptions{}
+	if opts != nil {
+		pageOpts = *opts
+	}
+
+	return listAll(func(after string) ([]*Action, *PaginationMeta, *http.Response, error) {
+		pageOpts.After = after
+		return s.list(ctx, &pageOpts)
+	})
+}
+
+func (s *ActionsService) list(ctx context.Context, opts *ListActionsOptions) ([]*Action, *PaginationMeta, *http.Response, error) {
+	u, err := addOptions("v2/actions", opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var result struct {
+		Actions        []*Action       `json:"actions"`
+		PaginationMeta *PaginationMeta `json:"pagination_meta,omitempty"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, nil, resp, err
+	}
+
+	return result.Actions, result.PaginationMeta, resp, nil
+}
+
+// Get returns a single action.
+func (s *ActionsService) Get(ctx context.Context, id string) (*Action, *http.Response, error) {
+	u := fmt.Sprintf("v2/actions/%s", id)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		Action *Action `json:"action"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.Action, resp, nil
+}
+
+// Update updates the status or assignee of an action.
+func (s *ActionsService) Update(ctx context.Context, id string, opts *UpdateActionOptions) (*Action, *http.Response, error) {
+	u := fmt.Sprintf("v2/actions/%s", id)
+
+	req, err := s.client.NewRequest("PUT", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		Action *Action `json:"action"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.Action, resp, nil
+}
@@ -0,0 +1,208 @@
+package incidentio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestActionsService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Authorization", "Bearer test-key")
+
+		if got := r.URL.Query().Get("incident_id"); got != "01FDAG4SAP5TYPT98WGR2N7W91" {
+			t.Errorf("incident_id = %q, want %q", got, "01FDAG4SAP5TYPT98WGR2N7W91")
+		}
+		if got := r.URL.Query().Get("incident_mode"); got != "standard" {
+			t.Errorf("incident_mode = %q, want %q", got, "standard")
+		}
+
+		response := `{
+			"actions": [
+				{
+					"id": "01FCNDV6P870EA6S7TK1DSYDG0",
+					"incident_id": "01FDAG4SAP5TYPT98WGR2N7W91",
+					"description": "Restart the connection pool",
+					"status": "outstanding",
+					"assignee": {
+						"id": "01FCNDV6P870EA6S7TK1DSYDG1",
+						"name": "Jane Smith",
+						"email": "jane@example.com",
+						"role": "admin"
+					},
+					"created_at": "2021-08-17T13:28:57.801578Z",
+					"updated_at": "2021-08-17T13:28:57.801578Z"
+				}
+			]
+		}`
+
+		_, _ = fmt.Fprint(w, response)
+	})
+
+	ctx := context.Background()
+	actions, _, err := client.Actions.List(ctx, &ListActionsOptions{
+		IncidentID:   "01FDAG4SAP5TYPT98WGR2N7W91",
+		IncidentMode: "standard",
+	})
+	if err != nil {
+		t.Errorf("Actions.List returned error: %v", err)
+	}
+
+	expected := []*Action{
+		{
+			ID:          "01FCNDV6P870EA6S7TK1DSYDG0",
+			IncidentID:  "01FDAG4SAP5TYPT98WGR2N7W91",
+			Description: "Restart the connection pool",
+			Status:      ActionStatusOutstanding,
+			Assignee: &User{
+				ID:    "01FCNDV6P870EA6S7TK1DSYDG1",
+				Name:  "Jane Smith",
+				Email: "jane@example.com",
+				Role:  "admin",
+			},
+			CreatedAt: Timestamp{parseTime("2021-08-17T13:28:57.801578Z")},
+			UpdatedAt: Timestamp{parseTime("2021-08-17T13:28:57.801578Z")},
+		},
+	}
+
+	if !reflect.DeepEqual(actions, expected) {
+		t.Errorf("Actions.List returned %+v, want %+v", actions, expected)
+	}
+}
+
+func TestActionsService_ListAll(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/v2/actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+
+		if got := r.URL.Query().Get("page_size"); got != "1" {
+			t.Errorf("page_size = %q, want %q", got, "1")
+		}
+
+		switch after := r.URL.Query().Get("after"); after {
+		case "":
+			_, _ = fmt.Fprint(w, `{
+				"actions": [{"id": "action-1", "status": "outstanding", "created_at": "2021-08-17T13:28:57Z", "updated_at": "2021-08-17T13:28:57Z"}],
+				"pagination_meta": {"after": "action-1", "page_size": 1}
+			}`)
+		case "action-1":
+			_, _ = fmt.Fprint(w, `{
+				"actions": [{"id": "action-2", "status": "completed", "created_at": "2021-08-17T13:28:57Z", "updated_at": "2021-08-17T13:28:57Z"}],
+				"pagination_meta": {"page_size": 1}
+			}`)
+		default:
+			t.Errorf("unexpected after cursor %q", after)
+		}
+	})
+
+	ctx := context.Background()
+	actions, _, err := client.Actions.ListAll(ctx, &ListActionsOptions{ListOptions: ListOptions{PageSize: 1}})
+	if err != nil {
+		t.Errorf("Actions.ListAll returned error: %v", err)
+	}
+
+	if calls != 2 {
+		t.Errorf("Actions.ListAll made %d requests, want 2", calls)
+	}
+
+	if len(actions) != 2 || actions[0].ID != "action-1" || actions[1].ID != "action-2" {
+		t.Errorf("Actions.ListAll returned %+v, want action-1 and action-2", actions)
+	}
+}
+
+func TestActionsService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/actions/01FCNDV6P870EA6S7TK1DSYDG0", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		response := `{
+			"action": {
+				"id": "01FCNDV6P870EA6S7TK1DSYDG0",
+				"incident_id": "01FDAG4SAP5TYPT98WGR2N7W91",
+				"description": "Restart the connection pool",
+				"status": "completed",
+				"completed_at": "2021-08-17T14:28:57.801578Z",
+				"created_at": "2021-08-17T13:28:57.801578Z",
+				"updated_at": "2021-08-17T14:28:57.801578Z"
+			}
+		}`
+
+		_, _ = fmt.Fprint(w, response)
+	})
+
+	ctx := context.Background()
+	action, _, err := client.Actions.Get(ctx, "01FCNDV6P870EA6S7TK1DSYDG0")
+	if err != nil {
+		t.Errorf("Actions.Get returned error: %v", err)
+	}
+
+	if action.Status != ActionStatusCompleted {
+		t.Errorf("Actions.Get returned Status %s, want %s", action.Status, ActionStatusCompleted)
+	}
+
+	if action.CompletedAt == nil {
+		t.Error("Actions.Get returned nil CompletedAt, want timestamp")
+	}
+}
+
+func TestActionsService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	status := ActionStatusCompleted
+	assigneeID := "01FCNDV6P870EA6S7TK1DSYDG1"
+	input := &UpdateActionOptions{
+		Status:     &status,
+		AssigneeID: &assigneeID,
+	}
+
+	mux.HandleFunc("/v2/actions/01FCNDV6P870EA6S7TK1DSYDG0", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "Content-Type", "application/json")
+
+		var received UpdateActionOptions
+		err := json.NewDecoder(r.Body).Decode(&received)
+		if err != nil {
+			t.Errorf("error decoding request body: %v", err)
+		}
+
+		if !reflect.DeepEqual(received, *input) {
+			t.Errorf("Request body = %+v, want %+v", received, *input)
+		}
+
+		response := `{
+			"action": {
+				"id": "01FCNDV6P870EA6S7TK1DSYDG0",
+				"status": "completed",
+				"assignee": {"id": "01FCNDV6P870EA6S7TK1DSYDG1", "name": "Jane Smith"},
+				"created_at": "2021-08-17T13:28:57.801578Z",
+				"updated_at": "2021-08-17T14:28:57.801578Z"
+			}
+		}`
+
+		_, _ = fmt.Fprint(w, response)
+	})
+
+	ctx := context.Background()
+	action, _, err := client.Actions.Update(ctx, "01FCNDV6P870EA6S7TK1DSYDG0", input)
+	if err != nil {
+		t.Errorf("Actions.Update returned error: %v", err)
+	}
+
+	if action.Assignee == nil || action.Assignee.ID != assigneeID {
+		t.Errorf("Actions.Update returned Assignee %+v, want ID %s", action.Assignee, assigneeID)
+	}
+}
@@ -0,0 +1,213 @@
+package incidentio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// AlertRoutesService handles communication with the alert route related
+// methods.
+type AlertRoutesService struct {
+	client *Client
+}
+
+// AlertRoute represents a routing rule deciding which alerts create
+// incidents, which escalation paths they page, and how they are grouped.
+type AlertRoute struct {
+	ID               string                      `json:"id"`
+	Name             string                      `json:"name"`
+	Enabled          bool                        `json:"enabled"`
+	IsPrivate        bool                        `json:"is_private"`
+	Version          int                         `json:"version"`
+	AlertSources     []AlertRouteAlertSource     `json:"alert_sources,omitempty"`
+	ConditionGroups  []ConditionGroup            `json:"condition_groups,omitempty"`
+	Expressions      []Expression                `json:"expressions,omitempty"`
+	EscalationConfig *AlertRouteEscalationConfig `json:"escalation_config,omitempty"`
+	IncidentConfig   *AlertRouteIncidentConfig   `json:"incident_config,omitempty"`
+	IncidentTemplate *AlertRouteIncidentTemplate `json:"incident_template,omitempty"`
+	CreatedAt        Timestamp                   `json:"created_at"`
+	UpdatedAt        Timestamp                   `json:"updated_at"`
+}
+
+// AlertRouteAlertSource represents an alert source feeding an alert route,
+// with the conditions its alerts must match.
+type AlertRouteAlertSource struct {
+	AlertSourceID   string           `json:"alert_source_id"`
+	ConditionGroups []ConditionGroup `json:"condition_groups,omitempty"`
+}
+
+// AlertRouteEscalationConfig represents who an alert route pages.
+type AlertRouteEscalationConfig struct {
+	AutoCancelEscalations bool                         `json:"auto_cancel_escalations"`
+	EscalationTargets     []AlertRouteEscalationTarget `json:"escalation_targets"`
+}
+
+// AlertRouteEscalationTarget represents an escalation path or set of users
+// paged by an alert route.
+type AlertRouteEscalationTarget struct {
+	EscalationPaths *ParamBinding `json:"escalation_paths,omitempty"`
+	Users           *ParamBinding `json:"users,omitempty"`
+}
+
+// AlertRouteIncidentConfig represents whether and how an alert route creates
+// incidents.
+type AlertRouteIncidentConfig struct {
+	Enabled               bool                    `json:"enabled"`
+	AutoDeclineEnabled    bool                    `json:"auto_decline_enabled"`
+	ConditionGroups       []ConditionGroup        `json:"condition_groups,omitempty"`
+	DeferTimeSeconds      int                     `json:"defer_time_seconds"`
+	GroupingKeys          []AlertRouteGroupingKey `json:"grouping_keys,omitempty"`
+	GroupingWindowSeconds int                     `json:"grouping_window_seconds"`
+}
+
+// AlertRouteGroupingKey represents an alert attribute used to group alerts
+// into the same incident.
+type AlertRouteGroupingKey struct {
+	Reference string `json:"reference"`
+}
+
+// AlertRouteIncidentTemplate represents the values of incidents created by
+// an alert route.
+type AlertRouteIncidentTemplate struct {
+	Name         *ParamBinding `json:"name,omitempty"`
+	Summary      *ParamBinding `json:"summary,omitempty"`
+	Severity     *ParamBinding `json:"severity,omitempty"`
+	IncidentType *ParamBinding `json:"incident_type,omitempty"`
+}
+
+// AlertRouteAlertSourcePayload is the request form of AlertRouteAlertSource.
+type AlertRouteAlertSourcePayload struct {
+	AlertSourceID   string                  `json:"alert_source_id"`
+	ConditionGroups []ConditionGroupPayload `json:"condition_groups"`
+}
+
+// AlertRouteIncidentConfigPayload is the request form of
+// AlertRouteIncidentConfig.
+type AlertRouteIncidentConfigPayload struct {
+	Enabled               bool                    `json:"enabled"`
+	AutoDeclineEnabled    bool                    `json:"auto_decline_enabled"`
+	ConditionGroups       []ConditionGroupPayload `json:"condition_groups"`
+	DeferTimeSeconds      int                     `json:"defer_time_seconds"`
+	GroupingKeys          []AlertRouteGroupingKey `json:"grouping_keys"`
+	GroupingWindowSeconds int                     `json:"grouping_window_seconds"`
+}
+
+// CreateAlertRouteOptions represents options for creating an alert route.
+type CreateAlertRouteOptions struct {
+	Name             string                           `json:"name"`
+	Enabled          bool                             `json:"enabled"`
+	IsPrivate        bool                             `json:"is_private"`
+	AlertSources     []AlertRouteAlertSourcePayload   `json:"alert_sources"`
+	ConditionGroups  []ConditionGroupPayload          `json:"condition_groups"`
+	Expressions      []ExpressionPayload              `json:"expressions"`
+	EscalationConfig *AlertRouteEscalationConfig      `json:"escalation_config,omitempty"`
+	IncidentConfig   *AlertRouteIncidentConfigPayload `json:"incident_config,omitempty"`
+	IncidentTemplate *AlertRouteIncidentTemplate      `json:"incident_template,omitempty"`
+}
+
+// UpdateAlertRouteOptions represents options for updating an alert route.
+// The route is replaced as a whole, so every field must be set.
+type UpdateAlertRouteOptions struct {
+	Name             string                           `json:"name"`
+	Enabled          bool                             `json:"enabled"`
+	IsPrivate        bool                             `json:"is_private"`
+	AlertSources     []AlertRouteAlertSourcePayload   `json:"alert_sources"`
+	ConditionGroups  []ConditionGroupPayload          `json:"condition_groups"`
+	Expressions      []ExpressionPayload              `json:"expressions"`
+	EscalationConfig *AlertRouteEscalationConfig      `json:"escalation_config,omitempty"`
+	IncidentConfig   *AlertRouteIncidentConfigPayload `json:"incident_config,omitempty"`
+	IncidentTemplate *AlertRouteIncidentTemplate      `json:"incident_template,omitempty"`
+}
+
+// List returns a list of alert routes.
+func (s *AlertRoutesService) List(ctx context.Context) ([]*AlertRoute, *http.Response, error) {
+	u := "v2/alert_routes"
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		AlertRoutes []*AlertRoute `json:"alert_routes"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.AlertRoutes, resp, nil
+}
+
+// Get returns a single alert route.
+func (s *AlertRoutesService) Get(ctx context.Context, id string) (*AlertRoute, *http.Response, error) {
+	u := fmt.Sprintf("v2/alert_routes/%s", id)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		AlertRoute *AlertRoute `json:"alert_route"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.AlertRoute, resp, nil
+}
+
+// Create creates a new alert route.
+func (s *AlertRoutesService) Create(ctx context.Context, opts *CreateAlertRouteOptions) (*AlertRoute, *http.Response, error) {
+	u := "v2/alert_routes"
+
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		AlertRoute *AlertRoute `json:"alert_route"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.AlertRoute, resp, nil
+}
+
+// Update updates an alert route.
+func (s *AlertRoutesService) Update(ctx context.Context, id string, opts *UpdateAlertRouteOptions) (*AlertRoute, *http.Response, error) {
+	u := fmt.Sprintf("v2/alert_routes/%s", id)
+
+	req, err := s.client.NewRequest("PUT", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		AlertRoute *AlertRoute `json:"alert_route"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.AlertRoute, resp, nil
+}
+
+// Delete deletes an alert route.
+func (s *AlertRoutesService) Delete(ctx context.Context, id string) (*http.Response, error) {
+	u := fmt.Sprintf("v2/alert_routes/%s", id)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
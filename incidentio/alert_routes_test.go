@@ -0,0 +1,279 @@
+package incidentio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestAlertRoutesService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/alert_routes/01HR8KX5Z3R4QBGC1FM0XAV1JW", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Authorization", "Bearer test-key")
+
+		response := `{
+			"alert_route": {
+				"id": "01HR8KX5Z3R4QBGC1FM0XAV1JW",
+				"name": "Database alerts",
+				"enabled": true,
+				"is_private": false,
+				"version": 4,
+				"alert_sources": [
+					{
+						"alert_source_id": "01HR8KX5Z3R4QBGC1FM0XAV1JX",
+						"condition_groups": [
+							{
+								"conditions": [
+									{
+										"subject": {"label": "Alert → Team", "reference": "alert.attributes.team"},
+										"operation": {"label": "is", "value": "is"},
+										"param_bindings": [{"value": {"literal": "database"}}]
+									}
+								]
+							}
+						]
+					}
+				],
+				"escalation_config": {
+					"auto_cancel_escalations": true,
+					"escalation_targets": [
+						{"escalation_paths": {"value": {"literal": "01HR8KX5Z3R4QBGC1FM0XAV1JY"}}}
+					]
+				},
+				"incident_config": {
+					"enabled": true,
+					"auto_decline_enabled": false,
+					"defer_time_seconds": 120,
+					"grouping_keys": [{"reference": "alert.attributes.service"}],
+					"grouping_window_seconds": 1800
+				},
+				"incident_template": {
+					"name": {"value": {"reference": "alert.title"}},
+					"severity": {"value": {"literal": "01FH5TZRWMNAFB0DZ23FD1V96N"}}
+				},
+				"created_at": "2024-03-01T10:00:00Z",
+				"updated_at": "2024-03-02T10:00:00Z"
+			}
+		}`
+
+		_, _ = fmt.Fprint(w, response)
+	})
+
+	ctx := context.Background()
+	route, _, err := client.AlertRoutes.Get(ctx, "01HR8KX5Z3R4QBGC1FM0XAV1JW")
+	if err != nil {
+		t.Errorf("AlertRoutes.Get returned error: %v", err)
+	}
+
+	expected := &AlertRoute{
+		ID:      "01HR8KX5Z3R4QBGC1FM0XAV1JW",
+		Name:    "Database alerts",
+		Enabled: true,
+		Version: 4,
+		AlertSources: []AlertRouteAlertSource{
+			{
+				AlertSourceID: "01HR8KX5Z3R4QBGC1FM0XAV1JX",
+				ConditionGroups: []ConditionGroup{
+					{
+						Conditions: []Condition{
+							{
+								Subject:       &ConditionSubject{Label: "Alert → Team", Reference: "alert.attributes.team"},
+								Operation:     &ConditionOperation{Label: "is", Value: "is"},
+								ParamBindings: []ParamBinding{{Value: &ParamBindingValue{Literal: "database"}}},
+							},
+						},
+					},
+				},
+			},
+		},
+		EscalationConfig: &AlertRouteEscalationConfig{
+			AutoCancelEscalations: true,
+			EscalationTargets: []AlertRouteEscalationTarget{
+				{EscalationPaths: &ParamBinding{Value: &ParamBindingValue{Literal: "01HR8KX5Z3R4QBGC1FM0XAV1JY"}}},
+			},
+		},
+		IncidentConfig: &AlertRouteIncidentConfig{
+			Enabled:               true,
+			DeferTimeSeconds:      120,
+			GroupingKeys:          []AlertRouteGroupingKey{{Reference: "alert.attributes.service"}},
+			GroupingWindowSeconds: 1800,
+		},
+		IncidentTemplate: &AlertRouteIncidentTemplate{
+			Name:     &ParamBinding{Value: &ParamBindingValue{Reference: "alert.title"}},
+			Severity: &ParamBinding{Value: &ParamBindingValue{Literal: "01FH5TZRWMNAFB0DZ23FD1V96N"}},
+		},
+		CreatedAt: Timestamp{parseTime("2024-03-01T10:00:00Z")},
+		UpdatedAt: Timestamp{parseTime("2024-03-02T10:00:00Z")},
+	}
+
+	if !reflect.DeepEqual(route, expected) {
+		t.Errorf("AlertRoutes.Get returned %+v, want %+v", route, expected)
+	}
+}
+
+func TestAlertRoutesService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/alert_routes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		_, _ = fmt.Fprint(w, `{
+			"alert_routes": [
+				{"id": "01HR8KX5Z3R4QBGC1FM0XAV1JW", "name": "Database alerts", "enabled": true, "version": 4},
+				{"id": "01HR8KX5Z3R4QBGC1FM0XAV1JZ", "name": "Catch-all", "enabled": false, "version": 1}
+			]
+		}`)
+	})
+
+	ctx := context.Background()
+	routes, _, err := client.AlertRoutes.List(ctx)
+	if err != nil {
+		t.Errorf("AlertRoutes.List returned error: %v", err)
+	}
+
+	if len(routes) != 2 {
+		t.Fatalf("AlertRoutes.List returned %d routes, want 2", len(routes))
+	}
+
+	if routes[1].Name != "Catch-all" || routes[1].Enabled {
+		t.Errorf("AlertRoutes.List returned %+v, want a disabled Catch-all route", routes[1])
+	}
+}
+
+func TestAlertRoutesService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CreateAlertRouteOptions{
+		Name:    "Database alerts",
+		Enabled: true,
+		AlertSources: []AlertRouteAlertSourcePayload{
+			{
+				AlertSourceID: "01HR8KX5Z3R4QBGC1FM0XAV1JX",
+				ConditionGroups: []ConditionGroupPayload{
+					{
+						Conditions: []ConditionPayload{
+							{
+								Subject:       "alert.attributes.team",
+								Operation:     "is",
+								ParamBindings: []ParamBinding{{Value: &ParamBindingValue{Literal: "database"}}},
+							},
+						},
+					},
+				},
+			},
+		},
+		ConditionGroups: []ConditionGroupPayload{},
+		Expressions:     []ExpressionPayload{},
+		EscalationConfig: &AlertRouteEscalationConfig{
+			AutoCancelEscalations: true,
+			EscalationTargets: []AlertRouteEscalationTarget{
+				{EscalationPaths: &ParamBinding{Value: &ParamBindingValue{Literal: "01HR8KX5Z3R4QBGC1FM0XAV1JY"}}},
+			},
+		},
+		IncidentConfig: &AlertRouteIncidentConfigPayload{
+			Enabled:               true,
+			ConditionGroups:       []ConditionGroupPayload{},
+			GroupingKeys:          []AlertRouteGroupingKey{{Reference: "alert.attributes.service"}},
+			GroupingWindowSeconds: 1800,
+		},
+	}
+
+	mux.HandleFunc("/v2/alert_routes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", "application/json")
+
+		var received CreateAlertRouteOptions
+		err := json.NewDecoder(r.Body).Decode(&received)
+		if err != nil {
+			t.Errorf("error decoding request body: %v", err)
+		}
+
+		if !reflect.DeepEqual(received, *input) {
+			t.Errorf("Request body = %+v, want %+v", received, *input)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `{"alert_route": {"id": "01HR8KX5Z3R4QBGC1FM0XAV1JW", "name": "Database alerts", "enabled": true, "version": 1}}`)
+	})
+
+	ctx := context.Background()
+	route, resp, err := client.AlertRoutes.Create(ctx, input)
+	if err != nil {
+		t.Errorf("AlertRoutes.Create returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Errorf("AlertRoutes.Create returned status %d, want %d", resp.StatusCode, http.StatusCreated)
+	}
+
+	if route.ID != "01HR8KX5Z3R4QBGC1FM0XAV1JW" {
+		t.Errorf("AlertRoutes.Create returned ID %s, want 01HR8KX5Z3R4QBGC1FM0XAV1JW", route.ID)
+	}
+}
+
+func TestAlertRoutesService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &UpdateAlertRouteOptions{
+		Name:            "Database alerts",
+		Enabled:         false,
+		AlertSources:    []AlertRouteAlertSourcePayload{},
+		ConditionGroups: []ConditionGroupPayload{},
+		Expressions:     []ExpressionPayload{},
+	}
+
+	mux.HandleFunc("/v2/alert_routes/01HR8KX5Z3R4QBGC1FM0XAV1JW", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+
+		var received UpdateAlertRouteOptions
+		err := json.NewDecoder(r.Body).Decode(&received)
+		if err != nil {
+			t.Errorf("error decoding request body: %v", err)
+		}
+
+		if !reflect.DeepEqual(received, *input) {
+			t.Errorf("Request body = %+v, want %+v", received, *input)
+		}
+
+		_, _ = fmt.Fprint(w, `{"alert_route": {"id": "01HR8KX5Z3R4QBGC1FM0XAV1JW", "name": "Database alerts", "enabled": false, "version": 5}}`)
+	})
+
+	ctx := context.Background()
+	route, _, err := client.AlertRoutes.Update(ctx, "01HR8KX5Z3R4QBGC1FM0XAV1JW", input)
+	if err != nil {
+		t.Errorf("AlertRoutes.Update returned error: %v", err)
+	}
+
+	if route.Enabled || route.Version != 5 {
+		t.Errorf("AlertRoutes.Update returned %+v, want a disabled route at version 5", route)
+	}
+}
+
+func TestAlertRoutesService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/alert_routes/01HR8KX5Z3R4QBGC1FM0XAV1JW", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	resp, err := client.AlertRoutes.Delete(ctx, "01HR8KX5Z3R4QBGC1FM0XAV1JW")
+	if err != nil {
+		t.Errorf("AlertRoutes.Delete returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("AlertRoutes.Delete returned status %d, want %d", resp.StatusCode, http.StatusNoContent)
+	}
+}
@@ -0,0 +1,244 @@
+package alertmanager
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/cpanato/go-incident-io/incidentio"
+)
+
+// Default templates used when a Config leaves them empty.
+const (
+	DefaultTitleTemplate       = `{{ with .Annotations.summary }}{{ . }}{{ else }}{{ .Labels.alertname }}{{ end }}`
+	DefaultDescriptionTemplate = `{{ .Annotations.description }}`
+)
+
+const maxBodyBytes = 1 << 20
+
+// Config configures a Bridge.
+type Config struct {
+	// SourceConfigID is the config ID of the Incident.io HTTP alert source.
+	SourceConfigID string
+	// Token is the secret token of the alert source. When empty, the
+	// client's API key is used.
+	Token string
+	// TitleTemplate and DescriptionTemplate are text/template strings
+	// evaluated against TemplateData. They default to DefaultTitleTemplate
+	// and DefaultDescriptionTemplate.
+	TitleTemplate       string
+	DescriptionTemplate string
+	// MetadataTemplates maps metadata keys to text/template strings
+	// evaluated against TemplateData. They are added on top of the alert
+	// labels, which are always sent as metadata.
+	MetadataTemplates map[string]string
+}
+
+// templateFuncs are the functions available to templates, in addition to the
+// text/template builtins. The string being transformed comes last, so they
+// can be used in pipelines: {{ .Labels.instance | trimSuffix ":9100" }}.
+var templateFuncs = template.FuncMap{
+	"toUpper":    strings.ToUpper,
+	"toLower":    strings.ToLower,
+	"trimPrefix": func(prefix, s string) string { return strings.TrimPrefix(s, prefix) },
+	"trimSuffix": func(suffix, s string) string { return strings.TrimSuffix(s, suffix) },
+	"replace":    func(old, repl, s string) string { return strings.ReplaceAll(s, old, repl) },
+}
+
+// TemplateData is the data templates are evaluated against. Labels,
+// Annotations and the other alert fields are available directly, e.g.
+// {{ .Labels.severity }}.
+type TemplateData struct {
+	Alert
+	GroupKey    string
+	Receiver    string
+	ExternalURL string
+}
+
+// Bridge maps Alertmanager notifications to Incident.io alert events. It
+// implements http.Handler, so it can receive Alertmanager webhooks directly.
+type Bridge struct {
+	client         *incidentio.Client
+	sourceConfigID string
+	token          string
+	title          *template.Template
+	description    *template.Template
+	metadata       map[string]*template.Template
+}
+
+// New returns a Bridge sending events through client.
+func New(client *incidentio.Client, cfg Config) (*Bridge, error) {
+	if cfg.SourceConfigID == "" {
+		return nil, errors.New("alertmanager: source config ID is required")
+	}
+	if cfg.TitleTemplate == "" {
+		cfg.TitleTemplate = DefaultTitleTemplate
+	}
+	if cfg.DescriptionTemplate == "" {
+		cfg.DescriptionTemplate = DefaultDescriptionTemplate
+	}
+
+	b := &Bridge{
+		client:         client,
+		sourceConfigID: cfg.SourceConfigID,
+		token:          cfg.Token,
+		metadata:       make(map[string]*template.Template, len(cfg.MetadataTemplates)),
+	}
+
+	var err error
+	if b.title, err = parseTemplate("title", cfg.TitleTemplate); err != nil {
+		return nil, err
+	}
+	if b.description, err = parseTemplate("description", cfg.DescriptionTemplate); err != nil {
+		return nil, err
+	}
+	for key, text := range cfg.MetadataTemplates {
+		if b.metadata[key], err = parseTemplate("metadata."+key, text); err != nil {
+			return nil, err
+		}
+	}
+
+	return b, nil
+}
+
+// Events returns the alert events for every alert in msg.
+func (b *Bridge) Events(msg *Message) ([]*incidentio.CreateAlertEventOptions, error) {
+	events := make([]*incidentio.CreateAlertEventOptions, 0, len(msg.Alerts))
+	for _, alert := range msg.Alerts {
+		data := &TemplateData{
+			Alert:       alert,
+			GroupKey:    msg.GroupKey,
+			Receiver:    msg.Receiver,
+			ExternalURL: msg.ExternalURL,
+		}
+
+		title, err := execute(b.title, data)
+		if err != nil {
+			return nil, err
+		}
+		description, err := execute(b.description, data)
+		if err != nil {
+			return nil, err
+		}
+
+		metadata := make(map[string]interface{}, len(alert.Labels)+len(b.metadata))
+		for k, v := range alert.Labels {
+			metadata[k] = v
+		}
+		for key, tmpl := range b.metadata {
+			if metadata[key], err = execute(tmpl, data); err != nil {
+				return nil, err
+			}
+		}
+
+		status := incidentio.AlertStatusFiring
+		if alert.Status == StatusResolved {
+			status = incidentio.AlertStatusResolved
+		}
+
+		events = append(events, &incidentio.CreateAlertEventOptions{
+			Token:            b.token,
+			DeduplicationKey: DeduplicationKey(msg.GroupKey, alert.Labels),
+			Status:           status,
+			Title:            title,
+			Description:      description,
+			Metadata:         metadata,
+			SourceURL:        alert.GeneratorURL,
+		})
+	}
+
+	return events, nil
+}
+
+// Forward sends an alert event for every alert in msg. It attempts every
+// alert and returns the combined errors of those that failed.
+func (b *Bridge) Forward(ctx context.Context, msg *Message) error {
+	events, err := b.Events(msg)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, event := range events {
+		if _, _, err := b.client.Alerts.CreateHTTPEvent(ctx, b.sourceConfigID, event); err != nil {
+			errs = append(errs, fmt.Errorf("alertmanager: sending %q: %w", event.Title, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ServeHTTP implements http.Handler for Alertmanager webhook receivers. A
+// failure to forward results in a 502, so that Alertmanager retries.
+func (b *Bridge) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	msg := &Message{}
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodyBytes)).Decode(msg); err != nil {
+		if errors.As(err, new(*http.MaxBytesError)) {
+			http.Error(w, "payload too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+	if msg.Version != "4" {
+		http.Error(w, fmt.Sprintf("unsupported payload version %q", msg.Version), http.StatusBadRequest)
+		return
+	}
+
+	if err := b.Forward(r.Context(), msg); err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// DeduplicationKey returns a stable key for an alert, derived from its group
+// key and labels. Firing and resolved notifications of the same alert share
+// the key, so the resolution closes the alert that was opened.
+func DeduplicationKey(groupKey string, labels map[string]string) string {
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	h := sha256.New()
+	h.Write([]byte(groupKey))
+	for _, name := range names {
+		fmt.Fprintf(h, "\x00%s=%s", name, labels[name])
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func parseTemplate(name, text string) (*template.Template, error) {
+	tmpl, err := template.New(name).Funcs(templateFuncs).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("alertmanager: parsing %s template: %w", name, err)
+	}
+
+	return tmpl, nil
+}
+
+func execute(tmpl *template.Template, data *TemplateData) (string, error) {
+	var b strings.Builder
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("alertmanager: executing %s template: %w", tmpl.Name(), err)
+	}
+
+	return strings.TrimSpace(b.String()), nil
+}
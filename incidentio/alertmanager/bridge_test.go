@@ -0,0 +1,235 @@
+package alertmanager
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/cpanato/go-incident-io/incidentio"
+)
+
+// fakeAPI records the alert events posted to it.
+type fakeAPI struct {
+	mu     sync.Mutex
+	events []map[string]interface{}
+	auth   []string
+	fail   bool
+}
+
+func setup(t *testing.T, cfg Config) (*Bridge, *fakeAPI) {
+	t.Helper()
+
+	api := &fakeAPI{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/alert_events/http/source-1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Request method: %v, want POST", r.Method)
+		}
+
+		api.mu.Lock()
+		defer api.mu.Unlock()
+
+		if api.fail {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"type":"internal_error","status":500}`))
+			return
+		}
+
+		var event map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+			t.Errorf("decoding event: %v", err)
+			return
+		}
+		api.events = append(api.events, event)
+		api.auth = append(api.auth, r.Header.Get("Authorization"))
+
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"status":"success","message":"Event accepted for processing"}`))
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client := incidentio.NewClient("test-key", incidentio.WithBaseURL(server.URL+"/"))
+
+	if cfg.SourceConfigID == "" {
+		cfg.SourceConfigID = "source-1"
+	}
+	b, err := New(client, cfg)
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+
+	return b, api
+}
+
+func post(t *testing.T, b *Bridge, fixture string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	body, err := os.ReadFile("testdata/" + fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := httptest.NewRecorder()
+	b.ServeHTTP(w, httptest.NewRequest("POST", "/webhook", strings.NewReader(string(body))))
+
+	return w
+}
+
+func TestBridge_Firing(t *testing.T) {
+	b, api := setup(t, Config{
+		Token: "source-token",
+		MetadataTemplates: map[string]string{
+			"host":      `{{ .Labels.instance | trimSuffix ":9100" }}`,
+			"team":      `{{ .Labels.team | toUpper }}`,
+			"dashboard": `{{ .ExternalURL }}/#/alerts?receiver={{ .Receiver }}`,
+		},
+	})
+
+	if w := post(t, b, "firing.json"); w.Code != http.StatusOK {
+		t.Fatalf("ServeHTTP returned %d: %s", w.Code, w.Body)
+	}
+
+	if len(api.events) != 2 {
+		t.Fatalf("API received %d events, want 2", len(api.events))
+	}
+
+	primary := api.events[0]
+	if primary["status"] != incidentio.AlertStatusFiring {
+		t.Errorf("status = %v, want firing", primary["status"])
+	}
+	if primary["title"] != "High CPU on db-primary" {
+		t.Errorf("title = %v, want the summary annotation", primary["title"])
+	}
+	if primary["description"] != "CPU above 90% for 10 minutes." {
+		t.Errorf("description = %v, want the description annotation", primary["description"])
+	}
+	if primary["source_url"] != "http://prometheus.example.com/graph?g0.expr=cpu" {
+		t.Errorf("source_url = %v, want the generator URL", primary["source_url"])
+	}
+
+	metadata := primary["metadata"].(map[string]interface{})
+	want := map[string]string{
+		"alertname": "HighCPU",
+		"team":      "DATABASE",
+		"host":      "db-primary",
+		"dashboard": "http://alertmanager.example.com/#/alerts?receiver=incident-io",
+	}
+	for k, v := range want {
+		if metadata[k] != v {
+			t.Errorf("metadata[%q] = %v, want %q", k, metadata[k], v)
+		}
+	}
+
+	replica := api.events[1]
+	if replica["title"] != "HighCPU" {
+		t.Errorf("title = %v, want the alert name when there is no summary", replica["title"])
+	}
+	if _, ok := replica["description"]; ok {
+		t.Errorf("description = %v, want it omitted", replica["description"])
+	}
+	if replica["deduplication_key"] == primary["deduplication_key"] {
+		t.Error("alerts with different labels share a deduplication key")
+	}
+
+	for _, auth := range api.auth {
+		if auth != "Bearer source-token" {
+			t.Errorf("Authorization = %q, want the source token", auth)
+		}
+	}
+}
+
+func TestBridge_Resolved(t *testing.T) {
+	b, api := setup(t, Config{})
+
+	if w := post(t, b, "firing.json"); w.Code != http.StatusOK {
+		t.Fatalf("ServeHTTP returned %d: %s", w.Code, w.Body)
+	}
+	if w := post(t, b, "resolved.json"); w.Code != http.StatusOK {
+		t.Fatalf("ServeHTTP returned %d: %s", w.Code, w.Body)
+	}
+
+	if len(api.events) != 3 {
+		t.Fatalf("API received %d events, want 3", len(api.events))
+	}
+
+	firing, resolved := api.events[0], api.events[2]
+	if resolved["status"] != incidentio.AlertStatusResolved {
+		t.Errorf("status = %v, want resolved", resolved["status"])
+	}
+	if resolved["deduplication_key"] != firing["deduplication_key"] {
+		t.Errorf("resolved deduplication key %v does not match firing key %v",
+			resolved["deduplication_key"], firing["deduplication_key"])
+	}
+	if api.auth[0] != "Bearer test-key" {
+		t.Errorf("Authorization = %q, want the API key when no token is set", api.auth[0])
+	}
+}
+
+func TestBridge_ServeHTTPErrors(t *testing.T) {
+	b, api := setup(t, Config{})
+
+	w := httptest.NewRecorder()
+	b.ServeHTTP(w, httptest.NewRequest("GET", "/webhook", nil))
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("GET returned %d, want %d", w.Code, http.StatusMethodNotAllowed)
+	}
+
+	w = httptest.NewRecorder()
+	b.ServeHTTP(w, httptest.NewRequest("POST", "/webhook", strings.NewReader(`{`)))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("invalid JSON returned %d, want %d", w.Code, http.StatusBadRequest)
+	}
+
+	w = httptest.NewRecorder()
+	oversized := `{"version":"4","receiver":"` + strings.Repeat("a", maxBodyBytes) + `"}`
+	b.ServeHTTP(w, httptest.NewRequest("POST", "/webhook", strings.NewReader(oversized)))
+	if w.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("oversized body returned %d, want %d", w.Code, http.StatusRequestEntityTooLarge)
+	}
+
+	w = httptest.NewRecorder()
+	b.ServeHTTP(w, httptest.NewRequest("POST", "/webhook", strings.NewReader(`{"version":"3","alerts":[]}`)))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("version 3 returned %d, want %d", w.Code, http.StatusBadRequest)
+	}
+
+	api.fail = true
+	if w := post(t, b, "firing.json"); w.Code != http.StatusBadGateway {
+		t.Errorf("API failure returned %d, want %d", w.Code, http.StatusBadGateway)
+	}
+}
+
+func TestNew_InvalidTemplate(t *testing.T) {
+	client := incidentio.NewClient("test-key")
+
+	if _, err := New(client, Config{}); err == nil {
+		t.Error("New without a source config ID returned no error")
+	}
+	if _, err := New(client, Config{SourceConfigID: "source-1", TitleTemplate: "{{ .Labels"}); err == nil {
+		t.Error("New with an invalid title template returned no error")
+	}
+	if _, err := New(client, Config{SourceConfigID: "source-1", MetadataTemplates: map[string]string{"x": "{{ end }}"}}); err == nil {
+		t.Error("New with an invalid metadata template returned no error")
+	}
+}
+
+func TestDeduplicationKey(t *testing.T) {
+	a := DeduplicationKey("group", map[string]string{"alertname": "HighCPU", "instance": "db-1"})
+	b := DeduplicationKey("group", map[string]string{"instance": "db-1", "alertname": "HighCPU"})
+	if a != b {
+		t.Errorf("DeduplicationKey depends on label order: %q != %q", a, b)
+	}
+
+	if c := DeduplicationKey("other-group", map[string]string{"alertname": "HighCPU", "instance": "db-1"}); c == a {
+		t.Error("DeduplicationKey ignores the group key")
+	}
+	if d := DeduplicationKey("group", map[string]string{"alertname": "HighCPU", "instance": "db-2"}); d == a {
+		t.Error("DeduplicationKey ignores label values")
+	}
+}
@@ -0,0 +1,3 @@
+// Package alertmanager forwards Prometheus Alertmanager webhook notifications
+// to an Incident.io HTTP alert source.
+package alertmanager
@@ -0,0 +1,200 @@
+package incidentio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Alert statuses.
+const (
+	AlertStatusFiring   = "firing"
+	AlertStatusResolved = "resolved"
+)
+
+// AlertsService handles communication with the alert related methods.
+type AlertsService struct {
+	client *Client
+}
+
+// AlertSource represents a source of alerts, such as an HTTP endpoint or a
+// monitoring integration.
+type AlertSource struct {
+	ID         string    `json:"id"`
+	Name       string    `json:"name"`
+	SourceType string    `json:"source_type"`
+	CreatedAt  Timestamp `json:"created_at"`
+	UpdatedAt  Timestamp `json:"updated_at"`
+}
+
+// Alert represents an alert received from an alert source.
+type Alert struct {
+	ID               string                 `json:"id"`
+	AlertSourceID    string                 `json:"alert_source_id"`
+	Title            string                 `json:"title"`
+	Description      string                 `json:"description,omitempty"`
+	Status           string                 `json:"status"`
+	DeduplicationKey string                 `json:"deduplication_key,omitempty"`
+	SourceURL        string                 `json:"source_url,omitempty"`
+	Metadata         map[string]interface{} `json:"metadata,omitempty"`
+	ResolvedAt       *Timestamp             `json:"resolved_at,omitempty"`
+	CreatedAt        Timestamp              `json:"created_at"`
+	UpdatedAt        Timestamp              `json:"updated_at"`
+}
+
+// CreateAlertEventOptions represents an alert event sent to an HTTP alert source.
+type CreateAlertEventOptions struct {
+	// Token is the secret token of the HTTP alert source. When empty, the
+	// client's API key is used.
+	Token string `json:"-"`
+
+	// DeduplicationKey groups events about the same alert, so that a later
+	// "resolved" event resolves the alert opened by an earlier "firing" one.
+	DeduplicationKey string                 `json:"deduplication_key,omitempty"`
+	Status           string                 `json:"status"`
+	Title            string                 `json:"title"`
+	Description      string                 `json:"description,omitempty"`
+	Metadata         map[string]interface{} `json:"metadata,omitempty"`
+	SourceURL        string                 `json:"source_url,omitempty"`
+}
+
+// AlertEventResult represents the response to an alert event.
+type AlertEventResult struct {
+	Status           string `json:"status"`
+	Message          string `json:"message"`
+	DeduplicationKey string `json:"deduplication_key"`
+}
+
+// ListAlertsOptions represents options for listing alerts.
+type ListAlertsOptions struct {
+	AlertSourceID    string `url:"alert_source_id,omitempty"`
+	Status           string `url:"status,omitempty"`
+	DeduplicationKey string `url:"deduplication_key,omitempty"`
+	ListOptions
+}
+
+// CreateHTTPEvent sends an alert event to the HTTP alert source with the
+// given config ID.
+func (s *AlertsService) CreateHTTPEvent(ctx context.Context, sourceConfigID string, opts *CreateAlertEventOptions) (*AlertEventResult, *http.Response, error) {
+	u := fmt.Sprintf("v2/alert_events/http/%s", sourceConfigID)
+
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if opts != nil && opts.Token != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", opts.Token))
+	}
+
+	result := &AlertEventResult{}
+	resp, err := s.client.Do(ctx, req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, nil
+}
+
+// ListSources returns a list of alert sources.
+func (s *AlertsService) ListSources(ctx context.Context) ([]*AlertSource, *http.Response, error) {
+	u := "v2/alert_sources"
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		AlertSources []*AlertSource `json:"alert_sources"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.AlertSources, resp, nil
+}
+
+// GetSource returns a single alert source.
+func (s *AlertsService) GetSource(ctx context.Context, id string) (*AlertSource, *http.Response, error) {
+	u := fmt.Sprintf("v2/alert_sources/%s", id)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		AlertSource *AlertSource `json:"alert_source"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.AlertSource, resp, nil
+}
+
+// List returns a single page of alerts.
+func (s *AlertsService) List(ctx context.Context, opts *ListAlertsOptions) ([]*Alert, *http.Response, error) {
+	alerts, _, resp, err := s.list(ctx, opts)
+	return alerts, resp, err
+}
+
+// ListAll returns all alerts matching opts, following pagination until
+// every page has been fetched.
+func (s *AlertsService) ListAll(ctx context.Context, opts *ListAlertsOptions) ([]*Alert, *http.Response, error) {
+	pageOpts := ListAlertsOptions{}
+	if opts != nil {
+		pageOpts = *opts
+	}
+
+	return listAll(func(after string) ([]*Alert, *PaginationMeta, *http.Response, error) {
+		pageOpts.After = after
+		return s.list(ctx, &pageOpts)
+	})
+}
+
+func (s *AlertsService) list(ctx context.Context, opts *ListAlertsOptions) ([]*Alert, *PaginationMeta, *http.Response, error) {
+	u, err := addOptions("v2/alerts", opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var result struct {
+		Alerts         []*Alert        `json:"alerts"`
+		PaginationMeta *PaginationMeta `json:"pagination_meta,omitempty"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, nil, resp, err
+	}
+
+	return result.Alerts, result.PaginationMeta, resp, nil
+}
+
+// Get returns a single alert.
+func (s *AlertsService) Get(ctx context.Context, id string) (*Alert, *http.Response, error) {
+	u := fmt.Sprintf("v2/alerts/%s", id)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		Alert *Alert `json:"alert"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.Alert, resp, nil
+}
@@ -0,0 +1,173 @@
+package incidentio
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestAlertsService_CreateHTTPEvent(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CreateAlertEventOptions{
+		Token:            "source-token",
+		DeduplicationKey: "db-primary-cpu",
+		Status:           AlertStatusFiring,
+		Title:            "High CPU on db-primary",
+		Description:      "CPU above 90% for 10 minutes",
+		Metadata:         map[string]interface{}{"host": "db-primary", "team": "database"},
+		SourceURL:        "https://grafana.example.com/d/db",
+	}
+
+	mux.HandleFunc("/v2/alert_events/http/01GW2G3V0S59R238FAHPDS1R66", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", "application/json")
+		testHeader(t, r, "Authorization", "Bearer source-token")
+
+		var received map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&received)
+		if err != nil {
+			t.Errorf("error decoding request body: %v", err)
+		}
+
+		expected := map[string]interface{}{
+			"deduplication_key": "db-primary-cpu",
+			"status":            "firing",
+			"title":             "High CPU on db-primary",
+			"description":       "CPU above 90% for 10 minutes",
+			"metadata":          map[string]interface{}{"host": "db-primary", "team": "database"},
+			"source_url":        "https://grafana.example.com/d/db",
+		}
+		if !reflect.DeepEqual(received, expected) {
+			t.Errorf("Request body = %+v, want %+v", received, expected)
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = fmt.Fprint(w, `{"status": "success", "message": "Event accepted for processing", "deduplication_key": "db-primary-cpu"}`)
+	})
+
+	ctx := context.Background()
+	result, resp, err := client.Alerts.CreateHTTPEvent(ctx, "01GW2G3V0S59R238FAHPDS1R66", input)
+	if err != nil {
+		t.Errorf("Alerts.CreateHTTPEvent returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusAccepted {
+		t.Errorf("Alerts.CreateHTTPEvent returned status %d, want %d", resp.StatusCode, http.StatusAccepted)
+	}
+
+	expected := &AlertEventResult{
+		Status:           "success",
+		Message:          "Event accepted for processing",
+		DeduplicationKey: "db-primary-cpu",
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Alerts.CreateHTTPEvent returned %+v, want %+v", result, expected)
+	}
+}
+
+func TestAlertsService_CreateHTTPEvent_APIKey(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/alert_events/http/01GW2G3V0S59R238FAHPDS1R66", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Authorization", "Bearer test-key")
+
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = fmt.Fprint(w, `{"type": "validation_error", "status": 422, "detail": "title is required"}`)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.Alerts.CreateHTTPEvent(ctx, "01GW2G3V0S59R238FAHPDS1R66", &CreateAlertEventOptions{Status: AlertStatusFiring})
+
+	errResp := &ErrorResponse{}
+	if !errors.As(err, &errResp) {
+		t.Fatalf("Error type = %T, want *ErrorResponse", err)
+	}
+
+	if errResp.Detail != "title is required" {
+		t.Errorf("Error detail = %s, want %s", errResp.Detail, "title is required")
+	}
+}
+
+func TestAlertsService_ListSources(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/alert_sources", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		_, _ = fmt.Fprint(w, `{
+			"alert_sources": [
+				{
+					"id": "01GW2G3V0S59R238FAHPDS1R66",
+					"name": "In-house monitoring",
+					"source_type": "http",
+					"created_at": "2021-08-17T13:28:57.801578Z",
+					"updated_at": "2021-08-17T13:28:57.801578Z"
+				}
+			]
+		}`)
+	})
+
+	ctx := context.Background()
+	sources, _, err := client.Alerts.ListSources(ctx)
+	if err != nil {
+		t.Errorf("Alerts.ListSources returned error: %v", err)
+	}
+
+	expected := []*AlertSource{
+		{
+			ID:         "01GW2G3V0S59R238FAHPDS1R66",
+			Name:       "In-house monitoring",
+			SourceType: "http",
+			CreatedAt:  Timestamp{parseTime("2021-08-17T13:28:57.801578Z")},
+			UpdatedAt:  Timestamp{parseTime("2021-08-17T13:28:57.801578Z")},
+		},
+	}
+	if !reflect.DeepEqual(sources, expected) {
+		t.Errorf("Alerts.ListSources returned %+v, want %+v", sources, expected)
+	}
+}
+
+func TestAlertsService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		if got := r.URL.Query().Get("status"); got != AlertStatusFiring {
+			t.Errorf("status = %q, want %q", got, AlertStatusFiring)
+		}
+
+		_, _ = fmt.Fprint(w, `{
+			"alerts": [
+				{
+					"id": "01GW2G3V0S59R238FAHPDS1R70",
+					"alert_source_id": "01GW2G3V0S59R238FAHPDS1R66",
+					"title": "High CPU on db-primary",
+					"status": "firing",
+					"deduplication_key": "db-primary-cpu",
+					"created_at": "2021-08-17T13:28:57.801578Z",
+					"updated_at": "2021-08-17T13:28:57.801578Z"
+				}
+			]
+		}`)
+	})
+
+	ctx := context.Background()
+	alerts, _, err := client.Alerts.List(ctx, &ListAlertsOptions{Status: AlertStatusFiring})
+	if err != nil {
+		t.Errorf("Alerts.List returned error: %v", err)
+	}
+
+	if len(alerts) != 1 || alerts[0].DeduplicationKey != "db-primary-cpu" {
+		t.Errorf("Alerts.List returned %+v, want db-primary-cpu alert", alerts)
+	}
+}
@@ -0,0 +1,395 @@
+package incidentio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Catalog attribute types. Attributes referencing another catalog type use
+// that type's TypeName, see CatalogReferenceType.
+const (
+	CatalogAttributeTypeText   = "Text"
+	CatalogAttributeTypeNumber = "Number"
+	CatalogAttributeTypeBool   = "Bool"
+)
+
+// Catalog attribute modes.
+const (
+	CatalogAttributeModeManual    = "manual"
+	CatalogAttributeModeAPI       = "api"
+	CatalogAttributeModeExternal  = "external"
+	CatalogAttributeModeDashboard = "dashboard"
+	CatalogAttributeModeBacklink  = "backlink"
+)
+
+// CatalogService handles communication with the catalog related methods.
+type CatalogService struct {
+	client *Client
+}
+
+// CatalogType represents a catalog type, such as Service or Team, in Incident.io.
+type CatalogType struct {
+	ID                   string             `json:"id"`
+	Name                 string             `json:"name"`
+	Description          string             `json:"description"`
+	TypeName             string             `json:"type_name"`
+	Color                string             `json:"color,omitempty"`
+	Icon                 string             `json:"icon,omitempty"`
+	Ranked               bool               `json:"ranked"`
+	IsEditable           bool               `json:"is_editable"`
+	Annotations          map[string]string  `json:"annotations,omitempty"`
+	Schema               *CatalogTypeSchema `json:"schema,omitempty"`
+	RequiredIntegrations []string           `json:"required_integrations,omitempty"`
+	SourceRepoURL        string             `json:"source_repo_url,omitempty"`
+	LastSyncedAt         *Timestamp         `json:"last_synced_at,omitempty"`
+	CreatedAt            Timestamp          `json:"created_at"`
+	UpdatedAt            Timestamp          `json:"updated_at"`
+}
+
+// CatalogTypeSchema represents the attributes of a catalog type.
+type CatalogTypeSchema struct {
+	Version    int                    `json:"version"`
+	Attributes []CatalogTypeAttribute `json:"attributes"`
+}
+
+// CatalogTypeAttribute represents an attribute in a catalog type schema.
+type CatalogTypeAttribute struct {
+	ID                string `json:"id,omitempty"`
+	Name              string `json:"name"`
+	Type              string `json:"type"`
+	Array             bool   `json:"array"`
+	Mode              string `json:"mode,omitempty"`
+	BacklinkAttribute string `json:"backlink_attribute,omitempty"`
+}
+
+// CatalogEntry represents an entry of a catalog type.
+type CatalogEntry struct {
+	ID              string                                `json:"id"`
+	CatalogTypeID   string                                `json:"catalog_type_id"`
+	Name            string                                `json:"name"`
+	Aliases         []string                              `json:"aliases,omitempty"`
+	ExternalID      string                                `json:"external_id,omitempty"`
+	Rank            int                                   `json:"rank"`
+	AttributeValues map[string]CatalogEntryAttributeValue `json:"attribute_values,omitempty"`
+	Annotations     map[string]string                     `json:"annotations,omitempty"`
+	ArchivedAt      *Timestamp                            `json:"archived_at,omitempty"`
+	CreatedAt       Timestamp                             `json:"created_at"`
+	UpdatedAt       Timestamp                             `json:"updated_at"`
+}
+
+// CatalogEntryAttributeValue represents the value of an attribute on a
+// catalog entry. Array attributes use ArrayValue, all others use Value.
+type CatalogEntryAttributeValue struct {
+	Value      *CatalogAttributeValue  `json:"value,omitempty"`
+	ArrayValue []CatalogAttributeValue `json:"array_value,omitempty"`
+}
+
+// CatalogAttributeValue represents a single attribute value. Literal holds
+// the value, or the ID or external ID of the referenced entry for reference
+// attributes.
+type CatalogAttributeValue struct {
+	Literal        string `json:"literal,omitempty"`
+	Label          string `json:"label,omitempty"`
+	CatalogEntryID string `json:"catalog_entry_id,omitempty"`
+}
+
+// CreateCatalogTypeOptions represents options for creating a catalog type.
+type CreateCatalogTypeOptions struct {
+	Name          string            `json:"name"`
+	Description   string            `json:"description"`
+	TypeName      string            `json:"type_name,omitempty"`
+	Ranked        bool              `json:"ranked,omitempty"`
+	Color         string            `json:"color,omitempty"`
+	Icon          string            `json:"icon,omitempty"`
+	Annotations   map[string]string `json:"annotations,omitempty"`
+	SourceRepoURL string            `json:"source_repo_url,omitempty"`
+}
+
+// UpdateCatalogTypeOptions represents options for updating a catalog type.
+type UpdateCatalogTypeOptions struct {
+	Name          *string           `json:"name,omitempty"`
+	Description   *string           `json:"description,omitempty"`
+	Ranked        *bool             `json:"ranked,omitempty"`
+	Color         *string           `json:"color,omitempty"`
+	Icon          *string           `json:"icon,omitempty"`
+	Annotations   map[string]string `json:"annotations,omitempty"`
+	SourceRepoURL *string           `json:"source_repo_url,omitempty"`
+}
+
+// UpdateCatalogTypeSchemaOptions represents options for updating the schema
+// of a catalog type. Version must match the current schema version.
+type UpdateCatalogTypeSchemaOptions struct {
+	Version    int                    `json:"version"`
+	Attributes []CatalogTypeAttribute `json:"attributes"`
+}
+
+// ListCatalogEntriesOptions represents options for listing catalog entries.
+type ListCatalogEntriesOptions struct {
+	CatalogTypeID string `url:"catalog_type_id"`
+	ListOptions
+}
+
+// CreateCatalogEntryOptions represents options for creating a catalog entry.
+type CreateCatalogEntryOptions struct {
+	CatalogTypeID   string                                `json:"catalog_type_id"`
+	Name            string                                `json:"name"`
+	Aliases         []string                              `json:"aliases,omitempty"`
+	ExternalID      string                                `json:"external_id,omitempty"`
+	Rank            int                                   `json:"rank,omitempty"`
+	AttributeValues map[string]CatalogEntryAttributeValue `json:"attribute_values"`
+	Annotations     map[string]string                     `json:"annotations,omitempty"`
+}
+
+// UpdateCatalogEntryOptions represents options for updating a catalog entry.
+// The entry is replaced as a whole, so every field must be set. Aliases and
+// Rank are always sent, so that empty values clear them.
+type UpdateCatalogEntryOptions struct {
+	Name            string                                `json:"name"`
+	Aliases         []string                              `json:"aliases"`
+	ExternalID      string                                `json:"external_id,omitempty"`
+	Rank            int                                   `json:"rank"`
+	AttributeValues map[string]CatalogEntryAttributeValue `json:"attribute_values"`
+	Annotations     map[string]string                     `json:"annotations,omitempty"`
+}
+
+// CatalogReferenceType returns the type name of a custom catalog type, which
+// is also the attribute type referencing its entries. For example
+// CatalogReferenceType("Service") returns `Custom["Service"]`.
+func CatalogReferenceType(typeName string) string {
+	return fmt.Sprintf("Custom[%q]", typeName)
+}
+
+// ListTypes returns a list of catalog types.
+func (s *CatalogService) ListTypes(ctx context.Context) ([]*CatalogType, *http.Response, error) {
+	u := "v2/catalog_types"
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		CatalogTypes []*CatalogType `json:"catalog_types"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.CatalogTypes, resp, nil
+}
+
+// GetType returns a single catalog type.
+func (s *CatalogService) GetType(ctx context.Context, id string) (*CatalogType, *http.Response, error) {
+	u := fmt.Sprintf("v2/catalog_types/%s", id)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		CatalogType *CatalogType `json:"catalog_type"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.CatalogType, resp, nil
+}
+
+// CreateType creates a new catalog type.
+func (s *CatalogService) CreateType(ctx context.Context, opts *CreateCatalogTypeOptions) (*CatalogType, *http.Response, error) {
+	u := "v2/catalog_types"
+
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		CatalogType *CatalogType `json:"catalog_type"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.CatalogType, resp, nil
+}
+
+// UpdateType updates a catalog type.
+func (s *CatalogService) UpdateType(ctx context.Context, id string, opts *UpdateCatalogTypeOptions) (*CatalogType, *http.Response, error) {
+	u := fmt.Sprintf("v2/catalog_types/%s", id)
+
+	req, err := s.client.NewRequest("PUT", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		CatalogType *CatalogType `json:"catalog_type"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.CatalogType, resp, nil
+}
+
+// UpdateTypeSchema replaces the attributes of a catalog type.
+func (s *CatalogService) UpdateTypeSchema(ctx context.Context, id string, opts *UpdateCatalogTypeSchemaOptions) (*CatalogType, *http.Response, error) {
+	u := fmt.Sprintf("v2/catalog_types/%s/actions/update_schema", id)
+
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		CatalogType *CatalogType `json:"catalog_type"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.CatalogType, resp, nil
+}
+
+// DeleteType deletes a catalog type and all of its entries.
+func (s *CatalogService) DeleteType(ctx context.Context, id string) (*http.Response, error) {
+	u := fmt.Sprintf("v2/catalog_types/%s", id)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListEntries returns a single page of entries of a catalog type.
+func (s *CatalogService) ListEntries(ctx context.Context, opts *ListCatalogEntriesOptions) ([]*CatalogEntry, *http.Response, error) {
+	entries, _, resp, err := s.listEntries(ctx, opts)
+	return entries, resp, err
+}
+
+// ListAllEntries returns every entry of a catalog type, following pagination
+// until every page has been fetched.
+func (s *CatalogService) ListAllEntries(ctx context.Context, opts *ListCatalogEntriesOptions) ([]*CatalogEntry, *http.Response, error) {
+	pageOpts := ListCatalogEntriesOptions{}
+	if opts != nil {
+		pageOpts = *opts
+	}
+
+	return listAll(func(after string) ([]*CatalogEntry, *PaginationMeta, *http.Response, error) {
+		pageOpts.After = after
+		return s.listEntries(ctx, &pageOpts)
+	})
+}
+
+func (s *CatalogService) listEntries(ctx context.Context, opts *ListCatalogEntriesOptions) ([]*CatalogEntry, *PaginationMeta, *http.Response, error) {
+	u, err := addOptions("v2/catalog_entries", opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var result struct {
+		CatalogEntries []*CatalogEntry `json:"catalog_entries"`
+		PaginationMeta *PaginationMeta `json:"pagination_meta,omitempty"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, nil, resp, err
+	}
+
+	return result.CatalogEntries, result.PaginationMeta, resp, nil
+}
+
+// GetEntry returns a single catalog entry.
+func (s *CatalogService) GetEntry(ctx context.Context, id string) (*CatalogEntry, *http.Response, error) {
+	u := fmt.Sprintf("v2/catalog_entries/%s", id)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		CatalogEntry *CatalogEntry `json:"catalog_entry"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.CatalogEntry, resp, nil
+}
+
+// CreateEntry creates a new catalog entry.
+func (s *CatalogService) CreateEntry(ctx context.Context, opts *CreateCatalogEntryOptions) (*CatalogEntry, *http.Response, error) {
+	u := "v2/catalog_entries"
+
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		CatalogEntry *CatalogEntry `json:"catalog_entry"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.CatalogEntry, resp, nil
+}
+
+// UpdateEntry updates a catalog entry.
+func (s *CatalogService) UpdateEntry(ctx context.Context, id string, opts *UpdateCatalogEntryOptions) (*CatalogEntry, *http.Response, error) {
+	u := fmt.Sprintf("v2/catalog_entries/%s", id)
+
+	if opts != nil && opts.Aliases == nil {
+		// Send an empty list rather than null to clear the aliases.
+		withAliases := *opts
+		withAliases.Aliases = []string{}
+		opts = &withAliases
+	}
+
+	req, err := s.client.NewRequest("PUT", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		CatalogEntry *CatalogEntry `json:"catalog_entry"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.CatalogEntry, resp, nil
+}
+
+// DeleteEntry deletes a catalog entry.
+func (s *CatalogService) DeleteEntry(ctx context.Context, id string) (*http.Response, error) {
+	u := fmt.Sprintf("v2/catalog_entries/%s", id)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,6 @@
+// Package catalog keeps the entries of an Incident.io catalog type in sync
+// with a declared set of entries.
+//
+// Desired entries are read from JSON with LoadEntries. YAML is not supported
+// directly; convert it first, for example with "yq -o json".
+package catalog
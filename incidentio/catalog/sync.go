@@ -0,0 +1,330 @@
+package catalog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/cpanato/go-incident-io/incidentio"
+)
+
+// ManagedByAnnotation is the entry annotation recording which source owns
+// an entry. A Sync only updates and deletes entries carrying its own marker.
+const ManagedByAnnotation = "go-incident-io/managed-by"
+
+// Errors returned for desired entries that would empty the catalog type.
+var (
+	// ErrNoEntries is returned by LoadEntries for an object without an
+	// "entries" key.
+	ErrNoEntries = errors.New(`catalog: no "entries" key in document`)
+	// ErrEmptyDesired is returned by Plan for an empty desired set, which
+	// would delete every managed entry, unless Sync.AllowEmpty is set.
+	ErrEmptyDesired = errors.New("catalog: no desired entries")
+)
+
+// Entry is a desired catalog entry, identified by its external ID.
+type Entry struct {
+	ExternalID      string                                           `json:"external_id"`
+	Name            string                                           `json:"name"`
+	Aliases         []string                                         `json:"aliases,omitempty"`
+	Rank            int                                              `json:"rank,omitempty"`
+	AttributeValues map[string]incidentio.CatalogEntryAttributeValue `json:"attribute_values,omitempty"`
+}
+
+// Action is the kind of change a Sync makes to an entry.
+type Action string
+
+// Actions of a plan.
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// Change is a single planned change. Current is nil for creates and Desired
+// is nil for deletes.
+type Change struct {
+	Action     Action
+	ExternalID string
+	Current    *incidentio.CatalogEntry
+	Desired    *Entry
+}
+
+// Plan is the set of changes needed to bring a catalog type in line with
+// the desired entries.
+type Plan struct {
+	Changes []Change
+	// Skipped holds desired entries whose external ID belongs to an entry
+	// not managed by this Sync. They are left untouched.
+	Skipped []Change
+}
+
+// String returns a human readable preview of the plan.
+func (p *Plan) String() string {
+	if len(p.Changes) == 0 && len(p.Skipped) == 0 {
+		return "No changes.\n"
+	}
+
+	var b strings.Builder
+	for _, c := range p.Changes {
+		switch c.Action {
+		case ActionCreate:
+			fmt.Fprintf(&b, "+ create %s (%s)\n", c.ExternalID, c.Desired.Name)
+		case ActionUpdate:
+			fmt.Fprintf(&b, "~ update %s (%s)\n", c.ExternalID, c.Desired.Name)
+		case ActionDelete:
+			fmt.Fprintf(&b, "- delete %s (%s)\n", c.ExternalID, c.Current.Name)
+		}
+	}
+	for _, c := range p.Skipped {
+		fmt.Fprintf(&b, "! skip %s: owned by %s\n", c.ExternalID, owner(c.Current))
+	}
+
+	return b.String()
+}
+
+// Sync reconciles the entries of one catalog type with a desired set.
+type Sync struct {
+	// AllowEmpty lets an empty desired set through, deleting every entry
+	// managed by the Sync.
+	AllowEmpty bool
+
+	client        *incidentio.Client
+	catalogTypeID string
+	managedBy     string
+}
+
+// NewSync returns a Sync for the catalog type with the given ID. managedBy
+// names the source of the entries, e.g. "service-registry", and is recorded
+// on every entry the Sync creates.
+func NewSync(client *incidentio.Client, catalogTypeID, managedBy string) *Sync {
+	return &Sync{
+		client:        client,
+		catalogTypeID: catalogTypeID,
+		managedBy:     managedBy,
+	}
+}
+
+// LoadEntries decodes desired entries from JSON, given either as an array
+// of entries or as an object with an "entries" array. Unknown fields are
+// rejected, so that a misspelt key cannot silently produce an empty set.
+// YAML is not supported; convert it to JSON first.
+func LoadEntries(r io.Reader) ([]*Entry, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		var entries []*Entry
+		if err := decodeStrict(trimmed, &entries); err != nil {
+			return nil, fmt.Errorf("catalog: decoding entries: %w", err)
+		}
+		return entries, nil
+	}
+
+	var doc struct {
+		Entries *[]*Entry `json:"entries"`
+	}
+	if err := decodeStrict(trimmed, &doc); err != nil {
+		return nil, fmt.Errorf("catalog: decoding entries: %w", err)
+	}
+	if doc.Entries == nil {
+		return nil, ErrNoEntries
+	}
+
+	return *doc.Entries, nil
+}
+
+func decodeStrict(data []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+
+	return dec.Decode(v)
+}
+
+// Plan compares desired with the current entries of the catalog type. An
+// empty desired set is rejected with ErrEmptyDesired unless AllowEmpty is set.
+func (s *Sync) Plan(ctx context.Context, desired []*Entry) (*Plan, error) {
+	if len(desired) == 0 && !s.AllowEmpty {
+		return nil, ErrEmptyDesired
+	}
+
+	wanted := make(map[string]*Entry, len(desired))
+	for _, d := range desired {
+		if d.ExternalID == "" {
+			return nil, fmt.Errorf("catalog: entry %q has no external ID", d.Name)
+		}
+		if _, ok := wanted[d.ExternalID]; ok {
+			return nil, fmt.Errorf("catalog: duplicate external ID %q", d.ExternalID)
+		}
+		wanted[d.ExternalID] = d
+	}
+
+	current, _, err := s.client.Catalog.ListAllEntries(ctx, &incidentio.ListCatalogEntriesOptions{
+		CatalogTypeID: s.catalogTypeID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make(map[string]*incidentio.CatalogEntry, len(current))
+	for _, c := range current {
+		if c.ExternalID != "" {
+			existing[c.ExternalID] = c
+		}
+	}
+
+	plan := &Plan{}
+	for _, d := range desired {
+		c, ok := existing[d.ExternalID]
+		switch {
+		case !ok:
+			plan.Changes = append(plan.Changes, Change{Action: ActionCreate, ExternalID: d.ExternalID, Desired: d})
+		case !s.manages(c):
+			plan.Skipped = append(plan.Skipped, Change{Action: ActionUpdate, ExternalID: d.ExternalID, Current: c, Desired: d})
+		case !matches(c, d):
+			plan.Changes = append(plan.Changes, Change{Action: ActionUpdate, ExternalID: d.ExternalID, Current: c, Desired: d})
+		}
+	}
+
+	for _, c := range current {
+		if _, ok := wanted[c.ExternalID]; !ok && s.manages(c) {
+			plan.Changes = append(plan.Changes, Change{Action: ActionDelete, ExternalID: c.ExternalID, Current: c})
+		}
+	}
+
+	sort.SliceStable(plan.Changes, func(i, j int) bool {
+		return plan.Changes[i].ExternalID < plan.Changes[j].ExternalID
+	})
+
+	return plan, nil
+}
+
+// Apply makes the changes of plan, stopping at the first failure.
+func (s *Sync) Apply(ctx context.Context, plan *Plan) error {
+	for _, c := range plan.Changes {
+		var err error
+		switch c.Action {
+		case ActionCreate:
+			_, _, err = s.client.Catalog.CreateEntry(ctx, &incidentio.CreateCatalogEntryOptions{
+				CatalogTypeID:   s.catalogTypeID,
+				Name:            c.Desired.Name,
+				Aliases:         c.Desired.Aliases,
+				ExternalID:      c.Desired.ExternalID,
+				Rank:            c.Desired.Rank,
+				AttributeValues: attributeValues(c.Desired),
+				Annotations:     map[string]string{ManagedByAnnotation: s.managedBy},
+			})
+		case ActionUpdate:
+			_, _, err = s.client.Catalog.UpdateEntry(ctx, c.Current.ID, &incidentio.UpdateCatalogEntryOptions{
+				Name:            c.Desired.Name,
+				Aliases:         c.Desired.Aliases,
+				ExternalID:      c.Desired.ExternalID,
+				Rank:            c.Desired.Rank,
+				AttributeValues: attributeValues(c.Desired),
+				Annotations:     s.annotations(c.Current),
+			})
+		case ActionDelete:
+			_, err = s.client.Catalog.DeleteEntry(ctx, c.Current.ID)
+		default:
+			err = errors.New("unknown action")
+		}
+		if err != nil {
+			return fmt.Errorf("catalog: %s %s: %w", c.Action, c.ExternalID, err)
+		}
+	}
+
+	return nil
+}
+
+// Run plans and applies the changes for desired in one step.
+func (s *Sync) Run(ctx context.Context, desired []*Entry) (*Plan, error) {
+	plan, err := s.Plan(ctx, desired)
+	if err != nil {
+		return nil, err
+	}
+
+	return plan, s.Apply(ctx, plan)
+}
+
+func (s *Sync) manages(e *incidentio.CatalogEntry) bool {
+	return e.Annotations[ManagedByAnnotation] == s.managedBy
+}
+
+// annotations returns the annotations of e with the managed-by marker set,
+// keeping any other annotations intact.
+func (s *Sync) annotations(e *incidentio.CatalogEntry) map[string]string {
+	annotations := make(map[string]string, len(e.Annotations)+1)
+	for k, v := range e.Annotations {
+		annotations[k] = v
+	}
+	annotations[ManagedByAnnotation] = s.managedBy
+
+	return annotations
+}
+
+func owner(e *incidentio.CatalogEntry) string {
+	if e == nil || e.Annotations[ManagedByAnnotation] == "" {
+		return "no sync"
+	}
+
+	return e.Annotations[ManagedByAnnotation]
+}
+
+func attributeValues(e *Entry) map[string]incidentio.CatalogEntryAttributeValue {
+	if e.AttributeValues == nil {
+		return map[string]incidentio.CatalogEntryAttributeValue{}
+	}
+
+	return e.AttributeValues
+}
+
+// matches reports whether the current entry already has the desired state.
+// Attribute values are compared by their literals only, as the API adds
+// labels and resolved entry IDs to the values it returns.
+func matches(c *incidentio.CatalogEntry, d *Entry) bool {
+	if c.Name != d.Name || c.Rank != d.Rank || !sameStrings(c.Aliases, d.Aliases) {
+		return false
+	}
+
+	return reflect.DeepEqual(literals(c.AttributeValues), literals(d.AttributeValues))
+}
+
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	a = append([]string(nil), a...)
+	b = append([]string(nil), b...)
+	sort.Strings(a)
+	sort.Strings(b)
+
+	return reflect.DeepEqual(a, b)
+}
+
+func literals(values map[string]incidentio.CatalogEntryAttributeValue) map[string][]string {
+	out := make(map[string][]string, len(values))
+	for id, v := range values {
+		var lits []string
+		if v.Value != nil && v.Value.Literal != "" {
+			lits = append(lits, v.Value.Literal)
+		}
+		for _, av := range v.ArrayValue {
+			lits = append(lits, av.Literal)
+		}
+		if len(lits) > 0 {
+			out[id] = lits
+		}
+	}
+
+	return out
+}
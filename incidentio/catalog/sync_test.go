@@ -0,0 +1,295 @@
+package catalog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/cpanato/go-incident-io/incidentio"
+)
+
+const currentEntries = `{
+	"catalog_entries": [
+		{
+			"id": "entry-unchanged",
+			"catalog_type_id": "type-1",
+			"name": "payments-api",
+			"external_id": "svc-payments",
+			"aliases": ["payments"],
+			"attribute_values": {"tier": {"value": {"literal": "1", "label": "1"}}},
+			"annotations": {"go-incident-io/managed-by": "registry"}
+		},
+		{
+			"id": "entry-changed",
+			"catalog_type_id": "type-1",
+			"name": "search",
+			"external_id": "svc-search",
+			"attribute_values": {"tier": {"value": {"literal": "3"}}},
+			"annotations": {"go-incident-io/managed-by": "registry", "owner": "search-team"}
+		},
+		{
+			"id": "entry-stale",
+			"catalog_type_id": "type-1",
+			"name": "legacy",
+			"external_id": "svc-legacy",
+			"annotations": {"go-incident-io/managed-by": "registry"}
+		},
+		{
+			"id": "entry-manual",
+			"catalog_type_id": "type-1",
+			"name": "hand-made",
+			"external_id": "svc-manual"
+		},
+		{
+			"id": "entry-other",
+			"catalog_type_id": "type-1",
+			"name": "billing",
+			"external_id": "svc-billing",
+			"annotations": {"go-incident-io/managed-by": "terraform"}
+		}
+	]
+}`
+
+func desiredEntries() []*Entry {
+	return []*Entry{
+		{
+			ExternalID:      "svc-payments",
+			Name:            "payments-api",
+			Aliases:         []string{"payments"},
+			AttributeValues: map[string]incidentio.CatalogEntryAttributeValue{"tier": {Value: &incidentio.CatalogAttributeValue{Literal: "1"}}},
+		},
+		{
+			ExternalID:      "svc-search",
+			Name:            "search",
+			AttributeValues: map[string]incidentio.CatalogEntryAttributeValue{"tier": {Value: &incidentio.CatalogAttributeValue{Literal: "2"}}},
+		},
+		{
+			ExternalID: "svc-new",
+			Name:       "new-service",
+		},
+		{
+			ExternalID: "svc-billing",
+			Name:       "billing",
+		},
+	}
+}
+
+func setup(t *testing.T) (*incidentio.Client, *[]string, map[string]json.RawMessage) {
+	t.Helper()
+
+	var calls []string
+	bodies := map[string]json.RawMessage{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/catalog_entries", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			if got := r.URL.Query().Get("catalog_type_id"); got != "type-1" {
+				t.Errorf("catalog_type_id = %q, want %q", got, "type-1")
+			}
+			_, _ = fmt.Fprint(w, currentEntries)
+			return
+		}
+
+		var body json.RawMessage
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		calls = append(calls, r.Method+" create")
+		bodies["create"] = body
+		_, _ = fmt.Fprint(w, `{"catalog_entry": {"id": "entry-created"}}`)
+	})
+	mux.HandleFunc("/v2/catalog_entries/", func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/v2/catalog_entries/")
+		calls = append(calls, r.Method+" "+id)
+
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		var body json.RawMessage
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		bodies[id] = body
+		_, _ = fmt.Fprintf(w, `{"catalog_entry": {"id": %q}}`, id)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client := incidentio.NewClient("test-key", incidentio.WithBaseURL(server.URL+"/"))
+
+	return client, &calls, bodies
+}
+
+func TestSync_Plan(t *testing.T) {
+	client, calls, _ := setup(t)
+	s := NewSync(client, "type-1", "registry")
+
+	plan, err := s.Plan(context.Background(), desiredEntries())
+	if err != nil {
+		t.Fatalf("Plan() error = %v", err)
+	}
+
+	var got []string
+	for _, c := range plan.Changes {
+		got = append(got, string(c.Action)+" "+c.ExternalID)
+	}
+	want := []string{"delete svc-legacy", "create svc-new", "update svc-search"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Plan() changes = %v, want %v", got, want)
+	}
+
+	if len(plan.Skipped) != 1 || plan.Skipped[0].ExternalID != "svc-billing" {
+		t.Errorf("Plan() skipped = %+v, want svc-billing", plan.Skipped)
+	}
+
+	if len(*calls) != 0 {
+		t.Errorf("Plan() made changes: %v", *calls)
+	}
+
+	preview := plan.String()
+	for _, line := range []string{"+ create svc-new", "~ update svc-search", "- delete svc-legacy", "! skip svc-billing: owned by terraform"} {
+		if !strings.Contains(preview, line) {
+			t.Errorf("Plan.String() = %q, missing %q", preview, line)
+		}
+	}
+}
+
+func TestSync_Run(t *testing.T) {
+	client, calls, bodies := setup(t)
+	s := NewSync(client, "type-1", "registry")
+
+	if _, err := s.Run(context.Background(), desiredEntries()); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	got := append([]string(nil), *calls...)
+	sort.Strings(got)
+	want := []string{"DELETE entry-stale", "POST create", "PUT entry-changed"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Run() calls = %v, want %v", got, want)
+	}
+
+	var created incidentio.CreateCatalogEntryOptions
+	_ = json.Unmarshal(bodies["create"], &created)
+	if created.CatalogTypeID != "type-1" || created.Annotations[ManagedByAnnotation] != "registry" {
+		t.Errorf("created entry = %+v, want type-1 managed by registry", created)
+	}
+
+	var updated incidentio.UpdateCatalogEntryOptions
+	_ = json.Unmarshal(bodies["entry-changed"], &updated)
+	wantAnnotations := map[string]string{ManagedByAnnotation: "registry", "owner": "search-team"}
+	if !reflect.DeepEqual(updated.Annotations, wantAnnotations) {
+		t.Errorf("updated annotations = %v, want %v", updated.Annotations, wantAnnotations)
+	}
+}
+
+func TestSync_Plan_InvalidDesired(t *testing.T) {
+	client, _, _ := setup(t)
+	s := NewSync(client, "type-1", "registry")
+
+	tests := []struct {
+		name    string
+		desired []*Entry
+	}{
+		{name: "missing external ID", desired: []*Entry{{Name: "nameless"}}},
+		{name: "duplicate external ID", desired: []*Entry{{ExternalID: "a", Name: "a"}, {ExternalID: "a", Name: "b"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := s.Plan(context.Background(), tt.desired); err == nil {
+				t.Error("Plan() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestLoadEntries(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+	}{
+		{name: "array", json: `[{"external_id": "svc-a", "name": "a"}]`},
+		{name: "object", json: `{"entries": [{"external_id": "svc-a", "name": "a"}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries, err := LoadEntries(strings.NewReader(tt.json))
+			if err != nil {
+				t.Fatalf("LoadEntries() error = %v", err)
+			}
+			if len(entries) != 1 || entries[0].ExternalID != "svc-a" {
+				t.Errorf("LoadEntries() = %+v, want svc-a", entries)
+			}
+		})
+	}
+
+	if _, err := LoadEntries(strings.NewReader(`not json`)); err == nil {
+		t.Error("LoadEntries() with invalid JSON returned nil error")
+	}
+
+	entries, err := LoadEntries(strings.NewReader(`{"entries": []}`))
+	if err != nil || len(entries) != 0 {
+		t.Errorf("LoadEntries() with empty entries = %v, %v, want no entries and no error", entries, err)
+	}
+}
+
+func TestLoadEntries_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    string
+		wantErr error
+		want    string
+	}{
+		{name: "missing entries key", json: `{"entires": [{"external_id": "svc-a", "name": "a"}]}`, want: "entires"},
+		{name: "empty object", json: `{}`, wantErr: ErrNoEntries},
+		{name: "unknown entry field", json: `[{"external_id": "svc-a", "nmae": "a"}]`, want: "nmae"},
+		{name: "wrong field type", json: `[{"external_id": "svc-a", "name": "a", "rank": "high"}]`, want: "rank"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadEntries(strings.NewReader(tt.json))
+			if err == nil {
+				t.Fatal("LoadEntries() error = nil, want error")
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("LoadEntries() error = %v, want %v", err, tt.wantErr)
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("LoadEntries() error = %v, want it to mention %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestSync_Plan_Empty(t *testing.T) {
+	client, _, _ := setup(t)
+	s := NewSync(client, "type-1", "registry")
+
+	if _, err := s.Plan(context.Background(), nil); !errors.Is(err, ErrEmptyDesired) {
+		t.Errorf("Plan() error = %v, want ErrEmptyDesired", err)
+	}
+
+	s.AllowEmpty = true
+	plan, err := s.Plan(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Plan() with AllowEmpty error = %v", err)
+	}
+
+	var got []string
+	for _, c := range plan.Changes {
+		got = append(got, string(c.Action)+" "+c.ExternalID)
+	}
+	want := []string{"delete svc-legacy", "delete svc-payments", "delete svc-search"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Plan() changes = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,537 @@
+package incidentio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestCatalogService_ListTypes(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/catalog_types", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Authorization", "Bearer test-key")
+
+		response := `{
+			"catalog_types": [
+				{
+					"id": "01FCNDV6P870EA6S7TK1DSYDG0",
+					"name": "Service",
+					"description": "Services we run",
+					"type_name": "Custom[\"Service\"]",
+					"ranked": false,
+					"is_editable": true,
+					"annotations": {"incident.io/catalog-importer/sync-id": "registry"},
+					"schema": {
+						"version": 2,
+						"attributes": [
+							{"id": "01GW2G3V0S59R238FAHPDS1R66", "name": "Tier", "type": "Number", "array": false, "mode": "api"},
+							{"id": "01GW2G3V0S59R238FAHPDS1R67", "name": "Owners", "type": "Custom[\"Team\"]", "array": true, "mode": "api"}
+						]
+					},
+					"created_at": "2021-08-17T13:28:57.801578Z",
+					"updated_at": "2021-08-17T13:28:57.801578Z"
+				}
+			]
+		}`
+
+		_, _ = fmt.Fprint(w, response)
+	})
+
+	ctx := context.Background()
+	types, _, err := client.Catalog.ListTypes(ctx)
+	if err != nil {
+		t.Errorf("Catalog.ListTypes returned error: %v", err)
+	}
+
+	expected := []*CatalogType{
+		{
+			ID:          "01FCNDV6P870EA6S7TK1DSYDG0",
+			Name:        "Service",
+			Description: "Services we run",
+			TypeName:    CatalogReferenceType("Service"),
+			IsEditable:  true,
+			Annotations: map[string]string{"incident.io/catalog-importer/sync-id": "registry"},
+			Schema: &CatalogTypeSchema{
+				Version: 2,
+				Attributes: []CatalogTypeAttribute{
+					{ID: "01GW2G3V0S59R238FAHPDS1R66", Name: "Tier", Type: CatalogAttributeTypeNumber, Mode: CatalogAttributeModeAPI},
+					{ID: "01GW2G3V0S59R238FAHPDS1R67", Name: "Owners", Type: CatalogReferenceType("Team"), Array: true, Mode: CatalogAttributeModeAPI},
+				},
+			},
+			CreatedAt: Timestamp{parseTime("2021-08-17T13:28:57.801578Z")},
+			UpdatedAt: Timestamp{parseTime("2021-08-17T13:28:57.801578Z")},
+		},
+	}
+
+	if !reflect.DeepEqual(types, expected) {
+		t.Errorf("Catalog.ListTypes returned %+v, want %+v", types, expected)
+	}
+}
+
+func TestCatalogService_UpdateTypeSchema(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &UpdateCatalogTypeSchemaOptions{
+		Version: 2,
+		Attributes: []CatalogTypeAttribute{
+			{Name: "Tier", Type: CatalogAttributeTypeNumber, Mode: CatalogAttributeModeAPI},
+			{Name: "Owners", Type: CatalogReferenceType("Team"), Array: true, Mode: CatalogAttributeModeAPI},
+		},
+	}
+
+	mux.HandleFunc("/v2/catalog_types/01FCNDV6P870EA6S7TK1DSYDG0/actions/update_schema", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", "application/json")
+
+		var received UpdateCatalogTypeSchemaOptions
+		err := json.NewDecoder(r.Body).Decode(&received)
+		if err != nil {
+			t.Errorf("error decoding request body: %v", err)
+		}
+
+		if !reflect.DeepEqual(received, *input) {
+			t.Errorf("Request body = %+v, want %+v", received, *input)
+		}
+
+		_, _ = fmt.Fprint(w, `{"catalog_type": {"id": "01FCNDV6P870EA6S7TK1DSYDG0", "name": "Service", "schema": {"version": 3, "attributes": []}}}`)
+	})
+
+	ctx := context.Background()
+	catalogType, _, err := client.Catalog.UpdateTypeSchema(ctx, "01FCNDV6P870EA6S7TK1DSYDG0", input)
+	if err != nil {
+		t.Errorf("Catalog.UpdateTypeSchema returned error: %v", err)
+	}
+
+	if catalogType.Schema.Version != 3 {
+		t.Errorf("Catalog.UpdateTypeSchema returned version %d, want %d", catalogType.Schema.Version, 3)
+	}
+}
+
+func TestCatalogService_ListAllEntries(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/catalog_entries", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		if got := r.URL.Query().Get("catalog_type_id"); got != "01FCNDV6P870EA6S7TK1DSYDG0" {
+			t.Errorf("catalog_type_id = %q, want %q", got, "01FCNDV6P870EA6S7TK1DSYDG0")
+		}
+
+		switch r.URL.Query().Get("after") {
+		case "":
+			_, _ = fmt.Fprint(w, `{
+				"catalog_entries": [
+					{
+						"id": "01GW2G3V0S59R238FAHPDS1R70",
+						"catalog_type_id": "01FCNDV6P870EA6S7TK1DSYDG0",
+						"name": "payments-api",
+						"aliases": ["payments"],
+						"external_id": "svc-payments",
+						"rank": 1,
+						"attribute_values": {
+							"01GW2G3V0S59R238FAHPDS1R66": {"value": {"literal": "1"}},
+							"01GW2G3V0S59R238FAHPDS1R67": {"array_value": [{"literal": "team-payments", "label": "Payments"}]}
+						},
+						"created_at": "2021-08-17T13:28:57.801578Z",
+						"updated_at": "2021-08-17T13:28:57.801578Z"
+					}
+				],
+				"pagination_meta": {"after": "01GW2G3V0S59R238FAHPDS1R70", "page_size": 1}
+			}`)
+		case "01GW2G3V0S59R238FAHPDS1R70":
+			_, _ = fmt.Fprint(w, `{"catalog_entries": [], "pagination_meta": {"page_size": 1}}`)
+		}
+	})
+
+	ctx := context.Background()
+	entries, _, err := client.Catalog.ListAllEntries(ctx, &ListCatalogEntriesOptions{CatalogTypeID: "01FCNDV6P870EA6S7TK1DSYDG0"})
+	if err != nil {
+		t.Errorf("Catalog.ListAllEntries returned error: %v", err)
+	}
+
+	expected := []*CatalogEntry{
+		{
+			ID:            "01GW2G3V0S59R238FAHPDS1R70",
+			CatalogTypeID: "01FCNDV6P870EA6S7TK1DSYDG0",
+			Name:          "payments-api",
+			Aliases:       []string{"payments"},
+			ExternalID:    "svc-payments",
+			Rank:          1,
+			AttributeValues: map[string]CatalogEntryAttributeValue{
+				"01GW2G3V0S59R238FAHPDS1R66": {Value: &CatalogAttributeValue{Literal: "1"}},
+				"01GW2G3V0S59R238FAHPDS1R67": {ArrayValue: []CatalogAttributeValue{{Literal: "team-payments", Label: "Payments"}}},
+			},
+			CreatedAt: Timestamp{parseTime("2021-08-17T13:28:57.801578Z")},
+			UpdatedAt: Timestamp{parseTime("2021-08-17T13:28:57.801578Z")},
+		},
+	}
+
+	if !reflect.DeepEqual(entries, expected) {
+		t.Errorf("Catalog.ListAllEntries returned %+v, want %+v", entries, expected)
+	}
+}
+
+func TestCatalogService_CreateEntry(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CreateCatalogEntryOptions{
+		CatalogTypeID: "01FCNDV6P870EA6S7TK1DSYDG0",
+		Name:          "payments-api",
+		Aliases:       []string{"payments"},
+		ExternalID:    "svc-payments",
+		AttributeValues: map[string]CatalogEntryAttributeValue{
+			"01GW2G3V0S59R238FAHPDS1R66": {Value: &CatalogAttributeValue{Literal: "1"}},
+		},
+	}
+
+	mux.HandleFunc("/v2/catalog_entries", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		var received CreateCatalogEntryOptions
+		err := json.NewDecoder(r.Body).Decode(&received)
+		if err != nil {
+			t.Errorf("error decoding request body: %v", err)
+		}
+
+		if !reflect.DeepEqual(received, *input) {
+			t.Errorf("Request body = %+v, want %+v", received, *input)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `{"catalog_entry": {"id": "01GW2G3V0S59R238FAHPDS1R70", "catalog_type_id": "01FCNDV6P870EA6S7TK1DSYDG0", "name": "payments-api", "external_id": "svc-payments"}}`)
+	})
+
+	ctx := context.Background()
+	entry, _, err := client.Catalog.CreateEntry(ctx, input)
+	if err != nil {
+		t.Errorf("Catalog.CreateEntry returned error: %v", err)
+	}
+
+	if entry.ID != "01GW2G3V0S59R238FAHPDS1R70" {
+		t.Errorf("Catalog.CreateEntry returned ID %s, want %s", entry.ID, "01GW2G3V0S59R238FAHPDS1R70")
+	}
+}
+
+func TestCatalogService_DeleteEntry(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/catalog_entries/01GW2G3V0S59R238FAHPDS1R70", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	resp, err := client.Catalog.DeleteEntry(ctx, "01GW2G3V0S59R238FAHPDS1R70")
+	if err != nil {
+		t.Errorf("Catalog.DeleteEntry returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Catalog.DeleteEntry returned status %d, want %d", resp.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestCatalogService_GetType(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/catalog_types/01FCNDV6P870EA6S7TK1DSYDG0", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Authorization", "Bearer test-key")
+
+		_, _ = fmt.Fprint(w, `{
+			"catalog_type": {
+				"id": "01FCNDV6P870EA6S7TK1DSYDG0",
+				"name": "Team",
+				"description": "Engineering teams",
+				"type_name": "Custom[\"Team\"]",
+				"ranked": true,
+				"is_editable": true,
+				"source_repo_url": "https://github.com/acme/catalog",
+				"last_synced_at": "2021-08-18T09:00:00Z",
+				"schema": {"version": 1, "attributes": []},
+				"created_at": "2021-08-17T13:28:57Z",
+				"updated_at": "2021-08-17T13:28:57Z"
+			}
+		}`)
+	})
+
+	ctx := context.Background()
+	catalogType, _, err := client.Catalog.GetType(ctx, "01FCNDV6P870EA6S7TK1DSYDG0")
+	if err != nil {
+		t.Errorf("Catalog.GetType returned error: %v", err)
+	}
+
+	expected := &CatalogType{
+		ID:            "01FCNDV6P870EA6S7TK1DSYDG0",
+		Name:          "Team",
+		Description:   "Engineering teams",
+		TypeName:      CatalogReferenceType("Team"),
+		Ranked:        true,
+		IsEditable:    true,
+		SourceRepoURL: "https://github.com/acme/catalog",
+		LastSyncedAt:  &Timestamp{parseTime("2021-08-18T09:00:00Z")},
+		Schema:        &CatalogTypeSchema{Version: 1, Attributes: []CatalogTypeAttribute{}},
+		CreatedAt:     Timestamp{parseTime("2021-08-17T13:28:57Z")},
+		UpdatedAt:     Timestamp{parseTime("2021-08-17T13:28:57Z")},
+	}
+
+	if !reflect.DeepEqual(catalogType, expected) {
+		t.Errorf("Catalog.GetType returned %+v, want %+v", catalogType, expected)
+	}
+}
+
+func TestCatalogService_CreateType(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CreateCatalogTypeOptions{
+		Name:        "Team",
+		Description: "Engineering teams",
+		TypeName:    CatalogReferenceType("Team"),
+		Ranked:      true,
+		Annotations: map[string]string{"go-incident-io/managed-by": "registry"},
+	}
+
+	mux.HandleFunc("/v2/catalog_types", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", "application/json")
+
+		var received CreateCatalogTypeOptions
+		err := json.NewDecoder(r.Body).Decode(&received)
+		if err != nil {
+			t.Errorf("error decoding request body: %v", err)
+		}
+
+		if !reflect.DeepEqual(received, *input) {
+			t.Errorf("Request body = %+v, want %+v", received, *input)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `{"catalog_type": {"id": "01FCNDV6P870EA6S7TK1DSYDG1", "name": "Team", "type_name": "Custom[\"Team\"]", "ranked": true}}`)
+	})
+
+	ctx := context.Background()
+	catalogType, _, err := client.Catalog.CreateType(ctx, input)
+	if err != nil {
+		t.Errorf("Catalog.CreateType returned error: %v", err)
+	}
+
+	expected := &CatalogType{
+		ID:       "01FCNDV6P870EA6S7TK1DSYDG1",
+		Name:     "Team",
+		TypeName: CatalogReferenceType("Team"),
+		Ranked:   true,
+	}
+
+	if !reflect.DeepEqual(catalogType, expected) {
+		t.Errorf("Catalog.CreateType returned %+v, want %+v", catalogType, expected)
+	}
+}
+
+func TestCatalogService_UpdateType(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	description := "Every engineering team"
+	ranked := false
+	input := &UpdateCatalogTypeOptions{Description: &description, Ranked: &ranked}
+
+	mux.HandleFunc("/v2/catalog_types/01FCNDV6P870EA6S7TK1DSYDG1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+
+		var received map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&received)
+		if err != nil {
+			t.Errorf("error decoding request body: %v", err)
+		}
+
+		// Only the fields being changed are sent, including a false Ranked.
+		want := map[string]interface{}{"description": description, "ranked": false}
+		if !reflect.DeepEqual(received, want) {
+			t.Errorf("Request body = %+v, want %+v", received, want)
+		}
+
+		_, _ = fmt.Fprint(w, `{"catalog_type": {"id": "01FCNDV6P870EA6S7TK1DSYDG1", "name": "Team", "description": "Every engineering team"}}`)
+	})
+
+	ctx := context.Background()
+	catalogType, _, err := client.Catalog.UpdateType(ctx, "01FCNDV6P870EA6S7TK1DSYDG1", input)
+	if err != nil {
+		t.Errorf("Catalog.UpdateType returned error: %v", err)
+	}
+
+	if catalogType.Description != description {
+		t.Errorf("Catalog.UpdateType returned description %q, want %q", catalogType.Description, description)
+	}
+}
+
+func TestCatalogService_DeleteType(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/catalog_types/01FCNDV6P870EA6S7TK1DSYDG1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	resp, err := client.Catalog.DeleteType(ctx, "01FCNDV6P870EA6S7TK1DSYDG1")
+	if err != nil {
+		t.Errorf("Catalog.DeleteType returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Catalog.DeleteType returned status %d, want %d", resp.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestCatalogService_GetEntry(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/catalog_entries/01GW2G3V0S59R238FAHPDS1R70", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		_, _ = fmt.Fprint(w, `{
+			"catalog_entry": {
+				"id": "01GW2G3V0S59R238FAHPDS1R70",
+				"catalog_type_id": "01FCNDV6P870EA6S7TK1DSYDG0",
+				"name": "payments-api",
+				"aliases": ["payments"],
+				"external_id": "svc-payments",
+				"rank": 2,
+				"attribute_values": {
+					"01GW2G3V0S59R238FAHPDS1R66": {"value": {"literal": "1", "label": "1"}},
+					"01GW2G3V0S59R238FAHPDS1R67": {"array_value": [{"catalog_entry_id": "01GW2G3V0S59R238FAHPDS1R80", "label": "Payments"}]}
+				},
+				"annotations": {"go-incident-io/managed-by": "registry"},
+				"created_at": "2021-08-17T13:28:57Z",
+				"updated_at": "2021-08-17T13:28:57Z"
+			}
+		}`)
+	})
+
+	ctx := context.Background()
+	entry, _, err := client.Catalog.GetEntry(ctx, "01GW2G3V0S59R238FAHPDS1R70")
+	if err != nil {
+		t.Errorf("Catalog.GetEntry returned error: %v", err)
+	}
+
+	expected := &CatalogEntry{
+		ID:            "01GW2G3V0S59R238FAHPDS1R70",
+		CatalogTypeID: "01FCNDV6P870EA6S7TK1DSYDG0",
+		Name:          "payments-api",
+		Aliases:       []string{"payments"},
+		ExternalID:    "svc-payments",
+		Rank:          2,
+		AttributeValues: map[string]CatalogEntryAttributeValue{
+			"01GW2G3V0S59R238FAHPDS1R66": {Value: &CatalogAttributeValue{Literal: "1", Label: "1"}},
+			"01GW2G3V0S59R238FAHPDS1R67": {ArrayValue: []CatalogAttributeValue{{CatalogEntryID: "01GW2G3V0S59R238FAHPDS1R80", Label: "Payments"}}},
+		},
+		Annotations: map[string]string{"go-incident-io/managed-by": "registry"},
+		CreatedAt:   Timestamp{parseTime("2021-08-17T13:28:57Z")},
+		UpdatedAt:   Timestamp{parseTime("2021-08-17T13:28:57Z")},
+	}
+
+	if !reflect.DeepEqual(entry, expected) {
+		t.Errorf("Catalog.GetEntry returned %+v, want %+v", entry, expected)
+	}
+}
+
+func TestCatalogService_UpdateEntry(t *testing.T) {
+	tests := []struct {
+		name  string
+		input *UpdateCatalogEntryOptions
+		want  string
+	}{
+		{
+			name: "full entry",
+			input: &UpdateCatalogEntryOptions{
+				Name:       "payments-api",
+				Aliases:    []string{"payments", "pay"},
+				ExternalID: "svc-payments",
+				Rank:       2,
+				AttributeValues: map[string]CatalogEntryAttributeValue{
+					"01GW2G3V0S59R238FAHPDS1R66": {Value: &CatalogAttributeValue{Literal: "1"}},
+				},
+				Annotations: map[string]string{"go-incident-io/managed-by": "registry"},
+			},
+			want: `{
+				"name": "payments-api",
+				"aliases": ["payments", "pay"],
+				"external_id": "svc-payments",
+				"rank": 2,
+				"attribute_values": {"01GW2G3V0S59R238FAHPDS1R66": {"value": {"literal": "1"}}},
+				"annotations": {"go-incident-io/managed-by": "registry"}
+			}`,
+		},
+		{
+			// The entry is replaced as a whole, so cleared aliases and a zero
+			// rank must still be sent.
+			name: "cleared aliases and rank",
+			input: &UpdateCatalogEntryOptions{
+				Name:            "payments-api",
+				ExternalID:      "svc-payments",
+				AttributeValues: map[string]CatalogEntryAttributeValue{},
+			},
+			want: `{
+				"name": "payments-api",
+				"aliases": [],
+				"external_id": "svc-payments",
+				"rank": 0,
+				"attribute_values": {}
+			}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc("/v2/catalog_entries/01GW2G3V0S59R238FAHPDS1R70", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "PUT")
+
+				var received, want map[string]interface{}
+				if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+					t.Errorf("error decoding request body: %v", err)
+				}
+				_ = json.Unmarshal([]byte(tt.want), &want)
+
+				if !reflect.DeepEqual(received, want) {
+					t.Errorf("Request body = %+v, want %+v", received, want)
+				}
+
+				_, _ = fmt.Fprint(w, `{"catalog_entry": {"id": "01GW2G3V0S59R238FAHPDS1R70", "name": "payments-api", "rank": 2}}`)
+			})
+
+			before := *tt.input
+
+			ctx := context.Background()
+			entry, _, err := client.Catalog.UpdateEntry(ctx, "01GW2G3V0S59R238FAHPDS1R70", tt.input)
+			if err != nil {
+				t.Errorf("Catalog.UpdateEntry returned error: %v", err)
+			}
+
+			if !reflect.DeepEqual(*tt.input, before) {
+				t.Errorf("Catalog.UpdateEntry modified its options: %+v, want %+v", *tt.input, before)
+			}
+
+			expected := &CatalogEntry{ID: "01GW2G3V0S59R238FAHPDS1R70", Name: "payments-api", Rank: 2}
+			if !reflect.DeepEqual(entry, expected) {
+				t.Errorf("Catalog.UpdateEntry returned %+v, want %+v", entry, expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package incidentio
+
+import (
+	"strconv"
+)
+
+// CustomFieldEntry represents the value of a custom field on an incident.
+type CustomFieldEntry struct {
+	CustomField *CustomField       `json:"custom_field"`
+	Values      []CustomFieldValue `json:"values"`
+}
+
+// CustomFieldValue represents a single value of a custom field. Which field
+// is set depends on the type of the custom field.
+type CustomFieldValue struct {
+	ValueOption       *CustomFieldOption `json:"value_option,omitempty"`
+	ValueText         string             `json:"value_text,omitempty"`
+	ValueNumeric      string             `json:"value_numeric,omitempty"`
+	ValueLink         string             `json:"value_link,omitempty"`
+	ValueCatalogEntry *CatalogEntry      `json:"value_catalog_entry,omitempty"`
+}
+
+// CustomFieldEntryPayload is the request form of CustomFieldEntry. Build it
+// with SingleSelect, MultiSelect, Text, Numeric, Link or CatalogEntries.
+type CustomFieldEntryPayload struct {
+	CustomFieldID string                    `json:"custom_field_id"`
+	Values        []CustomFieldValuePayload `json:"values"`
+}
+
+// CustomFieldValuePayload is the request form of CustomFieldValue.
+type CustomFieldValuePayload struct {
+	ValueOptionID       string `json:"value_option_id,omitempty"`
+	ValueText           string `json:"value_text,omitempty"`
+	ValueNumeric        string `json:"value_numeric,omitempty"`
+	ValueLink           string `json:"value_link,omitempty"`
+	ValueCatalogEntryID string `json:"value_catalog_entry_id,omitempty"`
+}
+
+// SingleSelect returns the value of a single select field set to the option
+// with the given ID.
+func SingleSelect(fieldID, optionID string) CustomFieldEntryPayload {
+	return MultiSelect(fieldID, optionID)
+}
+
+// MultiSelect returns the value of a multi select field set to the options
+// with the given IDs. With no options, the field is cleared.
+func MultiSelect(fieldID string, optionIDs ...string) CustomFieldEntryPayload {
+	entry := CustomFieldEntryPayload{CustomFieldID: fieldID, Values: []CustomFieldValuePayload{}}
+	for _, id := range optionIDs {
+		entry.Values = append(entry.Values, CustomFieldValuePayload{ValueOptionID: id})
+	}
+
+	return entry
+}
+
+// Text returns the value of a text field.
+func Text(fieldID, text string) CustomFieldEntryPayload {
+	return CustomFieldEntryPayload{
+		CustomFieldID: fieldID,
+		Values:        []CustomFieldValuePayload{{ValueText: text}},
+	}
+}
+
+// Numeric returns the value of a numeric field.
+func Numeric(fieldID string, value float64) CustomFieldEntryPayload {
+	return CustomFieldEntryPayload{
+		CustomFieldID: fieldID,
+		Values:        []CustomFieldValuePayload{{ValueNumeric: strconv.FormatFloat(value, 'f', -1, 64)}},
+	}
+}
+
+// Link returns the value of a link field.
+func Link(fieldID, url string) CustomFieldEntryPayload {
+	return CustomFieldEntryPayload{
+		CustomFieldID: fieldID,
+		Values:        []CustomFieldValuePayload{{ValueLink: url}},
+	}
+}
+
+// CatalogEntries returns the value of a catalog-backed field set to the
+// catalog entries with the given IDs. With no entries, the field is cleared.
+func CatalogEntries(fieldID string, entryIDs ...string) CustomFieldEntryPayload {
+	entry := CustomFieldEntryPayload{CustomFieldID: fieldID, Values: []CustomFieldValuePayload{}}
+	for _, id := range entryIDs {
+		entry.Values = append(entry.Values, CustomFieldValuePayload{ValueCatalogEntryID: id})
+	}
+
+	return entry
+}
+
+// CustomFieldEntry returns the entry of the custom field with the given name,
+// or nil if the incident has no value for it.
+func (i *Incident) CustomFieldEntry(name string) *CustomFieldEntry {
+	for idx := range i.CustomFieldEntries {
+		entry := &i.CustomFieldEntries[idx]
+		if entry.CustomField != nil && entry.CustomField.Name == name {
+			return entry
+		}
+	}
+
+	return nil
+}
+
+// CustomFieldOptions returns the selected options of the select field with
+// the given name.
+func (i *Incident) CustomFieldOptions(name string) []*CustomFieldOption {
+	entry := i.CustomFieldEntry(name)
+	if entry == nil {
+		return nil
+	}
+
+	var options []*CustomFieldOption
+	for _, v := range entry.Values {
+		if v.ValueOption != nil {
+			options = append(options, v.ValueOption)
+		}
+	}
+
+	return options
+}
+
+// CustomFieldOption returns the selected option of the single select field
+// with the given name.
+func (i *Incident) CustomFieldOption(name string) (*CustomFieldOption, bool) {
+	options := i.CustomFieldOptions(name)
+	if len(options) == 0 {
+		return nil, false
+	}
+
+	return options[0], true
+}
+
+// CustomFieldText returns the value of the text field with the given name.
+func (i *Incident) CustomFieldText(name string) (string, bool) {
+	entry := i.CustomFieldEntry(name)
+	if entry == nil || len(entry.Values) == 0 || entry.Values[0].ValueText == "" {
+		return "", false
+	}
+
+	return entry.Values[0].ValueText, true
+}
+
+// CustomFieldNumeric returns the value of the numeric field with the given
+// name. It reports false when the field is unset or not a number.
+func (i *Incident) CustomFieldNumeric(name string) (float64, bool) {
+	entry := i.CustomFieldEntry(name)
+	if entry == nil || len(entry.Values) == 0 {
+		return 0, false
+	}
+
+	value, err := strconv.ParseFloat(entry.Values[0].ValueNumeric, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return value, true
+}
+
+// CustomFieldLink returns the value of the link field with the given name.
+func (i *Incident) CustomFieldLink(name string) (string, bool) {
+	entry := i.CustomFieldEntry(name)
+	if entry == nil || len(entry.Values) == 0 || entry.Values[0].ValueLink == "" {
+		return "", false
+	}
+
+	return entry.Values[0].ValueLink, true
+}
+
+// CustomFieldCatalogEntries returns the catalog entries selected in the
+// catalog-backed field with the given name.
+func (i *Incident) CustomFieldCatalogEntries(name string) []*CatalogEntry {
+	entry := i.CustomFieldEntry(name)
+	if entry == nil {
+		return nil
+	}
+
+	var entries []*CatalogEntry
+	for _, v := range entry.Values {
+		if v.ValueCatalogEntry != nil {
+			entries = append(entries, v.ValueCatalogEntry)
+		}
+	}
+
+	return entries
+}
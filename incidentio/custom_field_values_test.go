@@ -0,0 +1,155 @@
+package incidentio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestCustomFieldEntryPayload_JSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		entry CustomFieldEntryPayload
+		want  string
+	}{
+		{"single select", SingleSelect("field-1", "option-1"), `{"custom_field_id":"field-1","values":[{"value_option_id":"option-1"}]}`},
+		{"multi select", MultiSelect("field-1", "option-1", "option-2"), `{"custom_field_id":"field-1","values":[{"value_option_id":"option-1"},{"value_option_id":"option-2"}]}`},
+		{"cleared", MultiSelect("field-1"), `{"custom_field_id":"field-1","values":[]}`},
+		{"text", Text("field-2", "Checkout is failing"), `{"custom_field_id":"field-2","values":[{"value_text":"Checkout is failing"}]}`},
+		{"numeric", Numeric("field-3", 12.5), `{"custom_field_id":"field-3","values":[{"value_numeric":"12.5"}]}`},
+		{"link", Link("field-4", "https://example.com/runbook"), `{"custom_field_id":"field-4","values":[{"value_link":"https://example.com/runbook"}]}`},
+		{"catalog entries", CatalogEntries("field-5", "entry-1"), `{"custom_field_id":"field-5","values":[{"value_catalog_entry_id":"entry-1"}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.entry)
+			if err != nil {
+				t.Fatalf("json.Marshal returned error: %v", err)
+			}
+
+			if string(got) != tt.want {
+				t.Errorf("json.Marshal returned %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIncidentsService_Create_CustomFieldEntries(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CreateIncidentOptions{
+		Name:           "Checkout errors",
+		IncidentTypeID: "type-1",
+		CustomFieldEntries: []CustomFieldEntryPayload{
+			SingleSelect("field-product", "option-api"),
+			CatalogEntries("field-service", "entry-payments", "entry-checkout"),
+		},
+	}
+
+	mux.HandleFunc("/v2/incidents", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		var received CreateIncidentOptions
+		err := json.NewDecoder(r.Body).Decode(&received)
+		if err != nil {
+			t.Errorf("error decoding request body: %v", err)
+		}
+
+		if !reflect.DeepEqual(received, *input) {
+			t.Errorf("Request body = %+v, want %+v", received, *input)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `{"incident": {"id": "incident-1", "name": "Checkout errors"}}`)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Incidents.Create(ctx, input); err != nil {
+		t.Errorf("Incidents.Create returned error: %v", err)
+	}
+}
+
+func TestIncident_CustomFieldAccessors(t *testing.T) {
+	data := `{
+		"id": "incident-1",
+		"custom_field_entries": [
+			{
+				"custom_field": {"id": "field-product", "name": "Affected product", "field_type": "single_select"},
+				"values": [{"value_option": {"id": "option-api", "custom_field_id": "field-product", "value": "API", "sort_key": 10}}]
+			},
+			{
+				"custom_field": {"id": "field-regions", "name": "Regions", "field_type": "multi_select"},
+				"values": [
+					{"value_option": {"id": "option-eu", "value": "EU", "sort_key": 1}},
+					{"value_option": {"id": "option-us", "value": "US", "sort_key": 2}}
+				]
+			},
+			{
+				"custom_field": {"id": "field-impact", "name": "Customer impact", "field_type": "text"},
+				"values": [{"value_text": "Payments are failing"}]
+			},
+			{
+				"custom_field": {"id": "field-orders", "name": "Failed orders", "field_type": "numeric"},
+				"values": [{"value_numeric": "1432"}]
+			},
+			{
+				"custom_field": {"id": "field-runbook", "name": "Runbook", "field_type": "link"},
+				"values": [{"value_link": "https://example.com/runbook"}]
+			},
+			{
+				"custom_field": {"id": "field-service", "name": "Affected service", "field_type": "multi_select", "catalog_type_id": "type-service"},
+				"values": [{"value_catalog_entry": {"id": "entry-payments", "name": "payments-api", "external_id": "svc-payments"}}]
+			}
+		]
+	}`
+
+	incident := &Incident{}
+	if err := json.Unmarshal([]byte(data), incident); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+
+	option, ok := incident.CustomFieldOption("Affected product")
+	if !ok || !reflect.DeepEqual(option, &CustomFieldOption{ID: "option-api", CustomFieldID: "field-product", Value: "API", SortKey: 10}) {
+		t.Errorf("CustomFieldOption returned %+v, %v", option, ok)
+	}
+
+	var regions []string
+	for _, o := range incident.CustomFieldOptions("Regions") {
+		regions = append(regions, o.Value)
+	}
+	if want := []string{"EU", "US"}; !reflect.DeepEqual(regions, want) {
+		t.Errorf("CustomFieldOptions returned %v, want %v", regions, want)
+	}
+
+	if text, ok := incident.CustomFieldText("Customer impact"); !ok || text != "Payments are failing" {
+		t.Errorf("CustomFieldText returned %q, %v", text, ok)
+	}
+
+	if n, ok := incident.CustomFieldNumeric("Failed orders"); !ok || n != 1432 {
+		t.Errorf("CustomFieldNumeric returned %v, %v", n, ok)
+	}
+
+	if link, ok := incident.CustomFieldLink("Runbook"); !ok || link != "https://example.com/runbook" {
+		t.Errorf("CustomFieldLink returned %q, %v", link, ok)
+	}
+
+	entries := incident.CustomFieldCatalogEntries("Affected service")
+	if len(entries) != 1 || entries[0].ExternalID != "svc-payments" {
+		t.Errorf("CustomFieldCatalogEntries returned %+v, want svc-payments", entries)
+	}
+
+	if _, ok := incident.CustomFieldText("Unknown field"); ok {
+		t.Error("CustomFieldText reported a value for an unknown field")
+	}
+	if _, ok := incident.CustomFieldNumeric("Customer impact"); ok {
+		t.Error("CustomFieldNumeric reported a value for a text field")
+	}
+	if _, ok := incident.CustomFieldOption("Runbook"); ok {
+		t.Error("CustomFieldOption reported a value for a link field")
+	}
+}
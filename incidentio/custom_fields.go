@@ -2,9 +2,19 @@ package incidentio
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 )
 
+// Custom field types.
+const (
+	CustomFieldTypeSingleSelect = "single_select"
+	CustomFieldTypeMultiSelect  = "multi_select"
+	CustomFieldTypeText         = "text"
+	CustomFieldTypeLink         = "link"
+	CustomFieldTypeNumeric      = "numeric"
+)
+
 // CustomFieldsService handles communication with the custom fields related methods.
 type CustomFieldsService struct {
 	client *Client
@@ -12,18 +22,84 @@ type CustomFieldsService struct {
 
 // CustomField represents a custom field in Incident.io.
 type CustomField struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	FieldType   string `json:"field_type"`
-	Options     []struct {
-		ID    string `json:"id"`
-		Value string `json:"value"`
-		Label string `json:"label"`
-	} `json:"options,omitempty"`
-	Required  bool      `json:"required"`
-	CreatedAt Timestamp `json:"created_at"`
-	UpdatedAt Timestamp `json:"updated_at"`
+	ID          string              `json:"id"`
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	FieldType   string              `json:"field_type"`
+	Options     []CustomFieldOption `json:"options,omitempty"`
+	// CatalogTypeID is set for select fields whose options are the entries
+	// of a catalog type rather than options managed on the field.
+	CatalogTypeID string `json:"catalog_type_id,omitempty"`
+	Required      bool   `json:"required"`
+	// IncidentTypeIDs restricts the field to incidents of these types. The
+	// field applies to every type when it is empty.
+	IncidentTypeIDs []string  `json:"incident_type_ids,omitempty"`
+	CreatedAt       Timestamp `json:"created_at"`
+	UpdatedAt       Timestamp `json:"updated_at"`
+}
+
+// CustomFieldOption represents an option of a single or multi select custom
+// field.
+type CustomFieldOption struct {
+	ID            string `json:"id"`
+	CustomFieldID string `json:"custom_field_id,omitempty"`
+	Value         string `json:"value"`
+	Label         string `json:"label,omitempty"`
+	SortKey       int    `json:"sort_key"`
+}
+
+// CreateCustomFieldOptions represents options for creating a custom field.
+type CreateCustomFieldOptions struct {
+	Name          string `json:"name"`
+	Description   string `json:"description"`
+	FieldType     string `json:"field_type"`
+	CatalogTypeID string `json:"catalog_type_id,omitempty"`
+	Required      bool   `json:"required,omitempty"`
+}
+
+// UpdateCustomFieldOptions represents options for updating a custom field.
+type UpdateCustomFieldOptions struct {
+	Name          *string `json:"name,omitempty"`
+	Description   *string `json:"description,omitempty"`
+	CatalogTypeID *string `json:"catalog_type_id,omitempty"`
+	Required      *bool   `json:"required,omitempty"`
+}
+
+// ListCustomFieldOptionsOptions represents options for listing the options
+// of a custom field.
+type ListCustomFieldOptionsOptions struct {
+	CustomFieldID string `url:"custom_field_id,omitempty"`
+	ListOptions
+}
+
+// CreateCustomFieldOptionOptions represents options for adding an option to
+// a custom field.
+type CreateCustomFieldOptionOptions struct {
+	CustomFieldID string `json:"custom_field_id"`
+	Value         string `json:"value"`
+	SortKey       *int   `json:"sort_key,omitempty"`
+}
+
+// UpdateCustomFieldOptionOptions represents options for updating a custom
+// field option.
+type UpdateCustomFieldOptionOptions struct {
+	Value   *string `json:"value,omitempty"`
+	SortKey *int    `json:"sort_key,omitempty"`
+}
+
+// AppliesTo reports whether the field is used by incidents of the type with
+// the given ID.
+func (f *CustomField) AppliesTo(incidentTypeID string) bool {
+	if len(f.IncidentTypeIDs) == 0 {
+		return true
+	}
+	for _, id := range f.IncidentTypeIDs {
+		if id == incidentTypeID {
+			return true
+		}
+	}
+
+	return false
 }
 
 // List returns a list of custom fields.
@@ -46,3 +122,171 @@ func (s *CustomFieldsService) List(ctx context.Context) ([]*CustomField, *http.R
 
 	return result.CustomFields, resp, nil
 }
+
+// Get returns a single custom field.
+func (s *CustomFieldsService) Get(ctx context.Context, id string) (*CustomField, *http.Response, error) {
+	u := fmt.Sprintf("v2/custom_fields/%s", id)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		CustomField *CustomField `json:"custom_field"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.CustomField, resp, nil
+}
+
+// Create creates a new custom field.
+func (s *CustomFieldsService) Create(ctx context.Context, opts *CreateCustomFieldOptions) (*CustomField, *http.Response, error) {
+	u := "v2/custom_fields"
+
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		CustomField *CustomField `json:"custom_field"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.CustomField, resp, nil
+}
+
+// Update updates a custom field.
+func (s *CustomFieldsService) Update(ctx context.Context, id string, opts *UpdateCustomFieldOptions) (*CustomField, *http.Response, error) {
+	u := fmt.Sprintf("v2/custom_fields/%s", id)
+
+	req, err := s.client.NewRequest("PUT", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		CustomField *CustomField `json:"custom_field"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.CustomField, resp, nil
+}
+
+// Delete deletes a custom field.
+func (s *CustomFieldsService) Delete(ctx context.Context, id string) (*http.Response, error) {
+	u := fmt.Sprintf("v2/custom_fields/%s", id)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListFieldOptions returns a single page of custom field options.
+func (s *CustomFieldsService) ListFieldOptions(ctx context.Context, opts *ListCustomFieldOptionsOptions) ([]*CustomFieldOption, *http.Response, error) {
+	options, _, resp, err := s.listFieldOptions(ctx, opts)
+	return options, resp, err
+}
+
+// ListAllFieldOptions returns all custom field options matching opts,
+// following pagination until every page has been fetched.
+func (s *CustomFieldsService) ListAllFieldOptions(ctx context.Context, opts *ListCustomFieldOptionsOptions) ([]*CustomFieldOption, *http.Response, error) {
+	pageOpts := ListCustomFieldOptionsOptions{}
+	if opts != nil {
+		pageOpts = *opts
+	}
+
+	return listAll(func(after string) ([]*CustomFieldOption, *PaginationMeta, *http.Response, error) {
+		pageOpts.After = after
+		return s.listFieldOptions(ctx, &pageOpts)
+	})
+}
+
+func (s *CustomFieldsService) listFieldOptions(ctx context.Context, opts *ListCustomFieldOptionsOptions) ([]*CustomFieldOption, *PaginationMeta, *http.Response, error) {
+	u, err := addOptions("v1/custom_field_options", opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var result struct {
+		CustomFieldOptions []*CustomFieldOption `json:"custom_field_options"`
+		PaginationMeta     *PaginationMeta      `json:"pagination_meta,omitempty"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, nil, resp, err
+	}
+
+	return result.CustomFieldOptions, result.PaginationMeta, resp, nil
+}
+
+// CreateFieldOption adds an option to a custom field.
+func (s *CustomFieldsService) CreateFieldOption(ctx context.Context, opts *CreateCustomFieldOptionOptions) (*CustomFieldOption, *http.Response, error) {
+	u := "v1/custom_field_options"
+
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		CustomFieldOption *CustomFieldOption `json:"custom_field_option"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.CustomFieldOption, resp, nil
+}
+
+// UpdateFieldOption updates a custom field option, such as its value or its
+// position in the sort order.
+func (s *CustomFieldsService) UpdateFieldOption(ctx context.Context, id string, opts *UpdateCustomFieldOptionOptions) (*CustomFieldOption, *http.Response, error) {
+	u := fmt.Sprintf("v1/custom_field_options/%s", id)
+
+	req, err := s.client.NewRequest("PUT", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		CustomFieldOption *CustomFieldOption `json:"custom_field_option"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.CustomFieldOption, resp, nil
+}
+
+// DeleteFieldOption deletes a custom field option.
+func (s *CustomFieldsService) DeleteFieldOption(ctx context.Context, id string) (*http.Response, error) {
+	u := fmt.Sprintf("v1/custom_field_options/%s", id)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,310 @@
+package incidentio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestCustomFieldsService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/custom_fields/field-product", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Authorization", "Bearer test-key")
+
+		_, _ = fmt.Fprint(w, `{
+			"custom_field": {
+				"id": "field-product",
+				"name": "Affected product",
+				"description": "Which product is impacted?",
+				"field_type": "single_select",
+				"options": [
+					{"id": "option-api", "custom_field_id": "field-product", "value": "API", "sort_key": 10},
+					{"id": "option-web", "custom_field_id": "field-product", "value": "Web", "sort_key": 20}
+				],
+				"required": true,
+				"created_at": "2021-08-17T13:28:57Z",
+				"updated_at": "2021-08-17T13:28:57Z"
+			}
+		}`)
+	})
+
+	ctx := context.Background()
+	field, _, err := client.CustomFields.Get(ctx, "field-product")
+	if err != nil {
+		t.Errorf("CustomFields.Get returned error: %v", err)
+	}
+
+	expected := &CustomField{
+		ID:          "field-product",
+		Name:        "Affected product",
+		Description: "Which product is impacted?",
+		FieldType:   CustomFieldTypeSingleSelect,
+		Options: []CustomFieldOption{
+			{ID: "option-api", CustomFieldID: "field-product", Value: "API", SortKey: 10},
+			{ID: "option-web", CustomFieldID: "field-product", Value: "Web", SortKey: 20},
+		},
+		Required:  true,
+		CreatedAt: Timestamp{parseTime("2021-08-17T13:28:57Z")},
+		UpdatedAt: Timestamp{parseTime("2021-08-17T13:28:57Z")},
+	}
+
+	if !reflect.DeepEqual(field, expected) {
+		t.Errorf("CustomFields.Get returned %+v, want %+v", field, expected)
+	}
+}
+
+func TestCustomFieldsService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CreateCustomFieldOptions{
+		Name:          "Affected service",
+		Description:   "Which service is impacted?",
+		FieldType:     CustomFieldTypeMultiSelect,
+		CatalogTypeID: "catalog-type-service",
+	}
+
+	mux.HandleFunc("/v2/custom_fields", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", "application/json")
+
+		var received CreateCustomFieldOptions
+		err := json.NewDecoder(r.Body).Decode(&received)
+		if err != nil {
+			t.Errorf("error decoding request body: %v", err)
+		}
+
+		if !reflect.DeepEqual(received, *input) {
+			t.Errorf("Request body = %+v, want %+v", received, *input)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `{
+			"custom_field": {
+				"id": "field-service",
+				"name": "Affected service",
+				"field_type": "multi_select",
+				"catalog_type_id": "catalog-type-service"
+			}
+		}`)
+	})
+
+	ctx := context.Background()
+	field, resp, err := client.CustomFields.Create(ctx, input)
+	if err != nil {
+		t.Errorf("CustomFields.Create returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Errorf("CustomFields.Create returned status %d, want %d", resp.StatusCode, http.StatusCreated)
+	}
+
+	if field.CatalogTypeID != "catalog-type-service" {
+		t.Errorf("CustomFields.Create returned CatalogTypeID %s, want catalog-type-service", field.CatalogTypeID)
+	}
+}
+
+func TestCustomFieldsService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	description := "Which product is impacted, if any?"
+	input := &UpdateCustomFieldOptions{Description: &description}
+
+	mux.HandleFunc("/v2/custom_fields/field-product", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+
+		var received UpdateCustomFieldOptions
+		err := json.NewDecoder(r.Body).Decode(&received)
+		if err != nil {
+			t.Errorf("error decoding request body: %v", err)
+		}
+
+		if !reflect.DeepEqual(received, *input) {
+			t.Errorf("Request body = %+v, want %+v", received, *input)
+		}
+
+		_, _ = fmt.Fprint(w, `{"custom_field": {"id": "field-product", "description": "Which product is impacted, if any?"}}`)
+	})
+
+	ctx := context.Background()
+	field, _, err := client.CustomFields.Update(ctx, "field-product", input)
+	if err != nil {
+		t.Errorf("CustomFields.Update returned error: %v", err)
+	}
+
+	if field.Description != description {
+		t.Errorf("CustomFields.Update returned Description %s, want %s", field.Description, description)
+	}
+}
+
+func TestCustomFieldsService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/custom_fields/field-product", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	resp, err := client.CustomFields.Delete(ctx, "field-product")
+	if err != nil {
+		t.Errorf("CustomFields.Delete returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("CustomFields.Delete returned status %d, want %d", resp.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestCustomFieldsService_ListAllFieldOptions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/v1/custom_field_options", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+
+		if got := r.URL.Query().Get("custom_field_id"); got != "field-product" {
+			t.Errorf("custom_field_id = %q, want %q", got, "field-product")
+		}
+
+		switch after := r.URL.Query().Get("after"); after {
+		case "":
+			_, _ = fmt.Fprint(w, `{
+				"custom_field_options": [{"id": "option-api", "custom_field_id": "field-product", "value": "API", "sort_key": 10}],
+				"pagination_meta": {"after": "option-api", "page_size": 1}
+			}`)
+		case "option-api":
+			_, _ = fmt.Fprint(w, `{
+				"custom_field_options": [{"id": "option-web", "custom_field_id": "field-product", "value": "Web", "sort_key": 20}],
+				"pagination_meta": {"page_size": 1}
+			}`)
+		default:
+			t.Errorf("unexpected after cursor %q", after)
+		}
+	})
+
+	ctx := context.Background()
+	options, _, err := client.CustomFields.ListAllFieldOptions(ctx, &ListCustomFieldOptionsOptions{
+		CustomFieldID: "field-product",
+		ListOptions:   ListOptions{PageSize: 1},
+	})
+	if err != nil {
+		t.Errorf("CustomFields.ListAllFieldOptions returned error: %v", err)
+	}
+
+	if calls != 2 {
+		t.Errorf("CustomFields.ListAllFieldOptions made %d requests, want 2", calls)
+	}
+
+	expected := []*CustomFieldOption{
+		{ID: "option-api", CustomFieldID: "field-product", Value: "API", SortKey: 10},
+		{ID: "option-web", CustomFieldID: "field-product", Value: "Web", SortKey: 20},
+	}
+
+	if !reflect.DeepEqual(options, expected) {
+		t.Errorf("CustomFields.ListAllFieldOptions returned %+v, want %+v", options, expected)
+	}
+}
+
+func TestCustomFieldsService_CreateOption(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	sortKey := 30
+	input := &CreateCustomFieldOptionOptions{
+		CustomFieldID: "field-product",
+		Value:         "Mobile",
+		SortKey:       &sortKey,
+	}
+
+	mux.HandleFunc("/v1/custom_field_options", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		var received CreateCustomFieldOptionOptions
+		err := json.NewDecoder(r.Body).Decode(&received)
+		if err != nil {
+			t.Errorf("error decoding request body: %v", err)
+		}
+
+		if !reflect.DeepEqual(received, *input) {
+			t.Errorf("Request body = %+v, want %+v", received, *input)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `{"custom_field_option": {"id": "option-mobile", "custom_field_id": "field-product", "value": "Mobile", "sort_key": 30}}`)
+	})
+
+	ctx := context.Background()
+	option, _, err := client.CustomFields.CreateFieldOption(ctx, input)
+	if err != nil {
+		t.Errorf("CustomFields.CreateFieldOption returned error: %v", err)
+	}
+
+	expected := &CustomFieldOption{ID: "option-mobile", CustomFieldID: "field-product", Value: "Mobile", SortKey: 30}
+	if !reflect.DeepEqual(option, expected) {
+		t.Errorf("CustomFields.CreateFieldOption returned %+v, want %+v", option, expected)
+	}
+}
+
+func TestCustomFieldsService_UpdateOption(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	sortKey := 5
+	input := &UpdateCustomFieldOptionOptions{SortKey: &sortKey}
+
+	mux.HandleFunc("/v1/custom_field_options/option-web", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("error decoding request body: %v", err)
+		}
+
+		if want := map[string]interface{}{"sort_key": float64(5)}; !reflect.DeepEqual(body, want) {
+			t.Errorf("Request body = %+v, want %+v", body, want)
+		}
+
+		_, _ = fmt.Fprint(w, `{"custom_field_option": {"id": "option-web", "custom_field_id": "field-product", "value": "Web", "sort_key": 5}}`)
+	})
+
+	ctx := context.Background()
+	option, _, err := client.CustomFields.UpdateFieldOption(ctx, "option-web", input)
+	if err != nil {
+		t.Errorf("CustomFields.UpdateFieldOption returned error: %v", err)
+	}
+
+	if option.SortKey != 5 {
+		t.Errorf("CustomFields.UpdateFieldOption returned SortKey %d, want 5", option.SortKey)
+	}
+}
+
+func TestCustomFieldsService_DeleteOption(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/custom_field_options/option-web", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	resp, err := client.CustomFields.DeleteFieldOption(ctx, "option-web")
+	if err != nil {
+		t.Errorf("CustomFields.DeleteFieldOption returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("CustomFields.DeleteFieldOption returned status %d, want %d", resp.StatusCode, http.StatusNoContent)
+	}
+}
@@ -0,0 +1,356 @@
+package incidentio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Escalation path node types.
+const (
+	EscalationPathNodeTypeLevel         = "level"
+	EscalationPathNodeTypeNotifyChannel = "notify_channel"
+	EscalationPathNodeTypeIfElse        = "if_else"
+	EscalationPathNodeTypeRepeat        = "repeat"
+)
+
+// Escalation path target types.
+const (
+	EscalationPathTargetTypeSchedule     = "schedule"
+	EscalationPathTargetTypeUser         = "user"
+	EscalationPathTargetTypeSlackChannel = "slack_channel"
+)
+
+// Escalation path target urgencies.
+const (
+	EscalationPathTargetUrgencyHigh = "high"
+	EscalationPathTargetUrgencyLow  = "low"
+)
+
+// Escalation statuses.
+const (
+	EscalationStatusPending   = "pending"
+	EscalationStatusTriggered = "triggered"
+	EscalationStatusAcked     = "acked"
+	EscalationStatusResolved  = "resolved"
+	EscalationStatusExpired   = "expired"
+	EscalationStatusCancelled = "cancelled"
+)
+
+// EscalationsService handles communication with the escalation path and
+// escalation related methods.
+type EscalationsService struct {
+	client *Client
+}
+
+// EscalationPath represents an on-call escalation path: the ordered levels
+// that are paged until someone acknowledges.
+type EscalationPath struct {
+	ID           string               `json:"id"`
+	Name         string               `json:"name"`
+	Path         []EscalationPathNode `json:"path"`
+	WorkingHours []WorkingHours       `json:"working_hours,omitempty"`
+	TeamIDs      []string             `json:"team_ids,omitempty"`
+}
+
+// EscalationPathNode represents a step of an escalation path. Only the field
+// matching Type is set.
+type EscalationPathNode struct {
+	ID            string                           `json:"id"`
+	Type          string                           `json:"type"`
+	Level         *EscalationPathNodeLevel         `json:"level,omitempty"`
+	NotifyChannel *EscalationPathNodeNotifyChannel `json:"notify_channel,omitempty"`
+	IfElse        *EscalationPathNodeIfElse        `json:"if_else,omitempty"`
+	Repeat        *EscalationPathNodeRepeat        `json:"repeat,omitempty"`
+}
+
+// EscalationPathNodeLevel represents a level of an escalation path, paging
+// its targets and waiting for an acknowledgement before moving on.
+type EscalationPathNodeLevel struct {
+	Targets          []EscalationPathTarget          `json:"targets"`
+	TimeToAckSeconds int                             `json:"time_to_ack_seconds,omitempty"`
+	RoundRobinConfig *EscalationPathRoundRobinConfig `json:"round_robin_config,omitempty"`
+}
+
+// EscalationPathRoundRobinConfig represents whether the targets of a level
+// are paged one at a time rather than all at once.
+type EscalationPathRoundRobinConfig struct {
+	Enabled            bool `json:"enabled"`
+	RotateAfterSeconds int  `json:"rotate_after_seconds,omitempty"`
+}
+
+// EscalationPathNodeNotifyChannel represents a step posting to Slack
+// channels.
+type EscalationPathNodeNotifyChannel struct {
+	Targets          []EscalationPathTarget `json:"targets"`
+	TimeToAckSeconds int                    `json:"time_to_ack_seconds,omitempty"`
+}
+
+// EscalationPathNodeIfElse represents a branch of an escalation path, such
+// as paging different levels in and out of working hours.
+type EscalationPathNodeIfElse struct {
+	Conditions []Condition          `json:"conditions"`
+	ThenPath   []EscalationPathNode `json:"then_path"`
+	ElsePath   []EscalationPathNode `json:"else_path,omitempty"`
+}
+
+// EscalationPathNodeRepeat represents a step jumping back to an earlier node
+// of the path.
+type EscalationPathNodeRepeat struct {
+	RepeatTimes int    `json:"repeat_times"`
+	ToNode      string `json:"to_node"`
+}
+
+// EscalationPathTarget represents a schedule, user or Slack channel paged by
+// an escalation path.
+type EscalationPathTarget struct {
+	ID           string `json:"id"`
+	Type         string `json:"type"`
+	Urgency      string `json:"urgency"`
+	ScheduleMode string `json:"schedule_mode,omitempty"`
+}
+
+// WorkingHours represents a named set of weekly intervals, in a timezone,
+// that escalation paths can branch on.
+type WorkingHours struct {
+	ID               string            `json:"id"`
+	Name             string            `json:"name"`
+	Timezone         string            `json:"timezone"`
+	WeekdayIntervals []WeekdayInterval `json:"weekday_intervals"`
+}
+
+// WeekdayInterval represents a time range on one day of the week.
+type WeekdayInterval struct {
+	Weekday   string `json:"weekday"`
+	StartTime string `json:"start_time"`
+	EndTime   string `json:"end_time"`
+}
+
+// EscalationPathNodePayload is the request form of EscalationPathNode.
+type EscalationPathNodePayload struct {
+	ID            string                           `json:"id,omitempty"`
+	Type          string                           `json:"type"`
+	Level         *EscalationPathNodeLevel         `json:"level,omitempty"`
+	NotifyChannel *EscalationPathNodeNotifyChannel `json:"notify_channel,omitempty"`
+	IfElse        *EscalationPathNodeIfElsePayload `json:"if_else,omitempty"`
+	Repeat        *EscalationPathNodeRepeat        `json:"repeat,omitempty"`
+}
+
+// EscalationPathNodeIfElsePayload is the request form of
+// EscalationPathNodeIfElse.
+type EscalationPathNodeIfElsePayload struct {
+	Conditions []ConditionPayload          `json:"conditions"`
+	ThenPath   []EscalationPathNodePayload `json:"then_path"`
+	ElsePath   []EscalationPathNodePayload `json:"else_path,omitempty"`
+}
+
+// CreateEscalationPathOptions represents options for creating an escalation
+// path.
+type CreateEscalationPathOptions struct {
+	Name         string                      `json:"name"`
+	Path         []EscalationPathNodePayload `json:"path"`
+	WorkingHours []WorkingHours              `json:"working_hours,omitempty"`
+	TeamIDs      []string                    `json:"team_ids,omitempty"`
+}
+
+// UpdateEscalationPathOptions represents options for updating an escalation
+// path. The path is replaced as a whole, so every field must be set.
+type UpdateEscalationPathOptions struct {
+	Name         string                      `json:"name"`
+	Path         []EscalationPathNodePayload `json:"path"`
+	WorkingHours []WorkingHours              `json:"working_hours,omitempty"`
+	TeamIDs      []string                    `json:"team_ids,omitempty"`
+}
+
+// Escalation represents a page sent through an escalation path or directly
+// to users.
+type Escalation struct {
+	ID               string              `json:"id"`
+	Title            string              `json:"title"`
+	Description      string              `json:"description,omitempty"`
+	Status           string              `json:"status"`
+	EscalationPathID string              `json:"escalation_path_id,omitempty"`
+	Priority         *EscalationPriority `json:"priority,omitempty"`
+	CreatedAt        Timestamp           `json:"created_at"`
+	UpdatedAt        Timestamp           `json:"updated_at"`
+}
+
+// EscalationPriority represents the priority of an escalation.
+type EscalationPriority struct {
+	Name string `json:"name"`
+}
+
+// CreateEscalationOptions represents options for creating a manual
+// escalation. Set either EscalationPathID or UserIDs.
+type CreateEscalationOptions struct {
+	// IdempotencyKey makes retried requests create a single escalation.
+	IdempotencyKey   string   `json:"idempotency_key"`
+	Title            string   `json:"title"`
+	Description      string   `json:"description,omitempty"`
+	EscalationPathID string   `json:"escalation_path_id,omitempty"`
+	UserIDs          []string `json:"user_ids,omitempty"`
+	PriorityID       string   `json:"priority_id,omitempty"`
+}
+
+// ListEscalationsOptions represents options for listing escalations.
+type ListEscalationsOptions struct {
+	EscalationPathID string `url:"escalation_path_id,omitempty"`
+	Status           string `url:"status,omitempty"`
+	ListOptions
+}
+
+// GetPath returns a single escalation path.
+func (s *EscalationsService) GetPath(ctx context.Context, id string) (*EscalationPath, *http.Response, error) {
+	u := fmt.Sprintf("v2/escalation_paths/%s", id)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		EscalationPath *EscalationPath `json:"escalation_path"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.EscalationPath, resp, nil
+}
+
+// CreatePath creates a new escalation path.
+func (s *EscalationsService) CreatePath(ctx context.Context, opts *CreateEscalationPathOptions) (*EscalationPath, *http.Response, error) {
+	u := "v2/escalation_paths"
+
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		EscalationPath *EscalationPath `json:"escalation_path"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.EscalationPath, resp, nil
+}
+
+// UpdatePath updates an escalation path.
+func (s *EscalationsService) UpdatePath(ctx context.Context, id string, opts *UpdateEscalationPathOptions) (*EscalationPath, *http.Response, error) {
+	u := fmt.Sprintf("v2/escalation_paths/%s", id)
+
+	req, err := s.client.NewRequest("PUT", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		EscalationPath *EscalationPath `json:"escalation_path"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.EscalationPath, resp, nil
+}
+
+// DeletePath deletes an escalation path.
+func (s *EscalationsService) DeletePath(ctx context.Context, id string) (*http.Response, error) {
+	u := fmt.Sprintf("v2/escalation_paths/%s", id)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// Create pages an escalation path or a set of users, outside of any
+// incident.
+func (s *EscalationsService) Create(ctx context.Context, opts *CreateEscalationOptions) (*Escalation, *http.Response, error) {
+	u := "v2/escalations"
+
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		Escalation *Escalation `json:"escalation"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.Escalation, resp, nil
+}
+
+// Get returns a single escalation, including its current status.
+func (s *EscalationsService) Get(ctx context.Context, id string) (*Escalation, *http.Response, error) {
+	u := fmt.Sprintf("v2/escalations/%s", id)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		Escalation *Escalation `json:"escalation"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.Escalation, resp, nil
+}
+
+// List returns a single page of escalations.
+func (s *EscalationsService) List(ctx context.Context, opts *ListEscalationsOptions) ([]*Escalation, *http.Response, error) {
+	escalations, _, resp, err := s.list(ctx, opts)
+	return escalations, resp, err
+}
+
+// ListAll returns all escalations matching opts, following pagination until
+// every page has been fetched.
+func (s *EscalationsService) ListAll(ctx context.Context, opts *ListEscalationsOptions) ([]*Escalation, *http.Response, error) {
+	pageOpts := ListEscalationsOptions{}
+	if opts != nil {
+		pageOpts = *opts
+	}
+
+	return listAll(func(after string) ([]*Escalation, *PaginationMeta, *http.Response, error) {
+		pageOpts.After = after
+		return s.list(ctx, &pageOpts)
+	})
+}
+
+func (s *EscalationsService) list(ctx context.Context, opts *ListEscalationsOptions) ([]*Escalation, *PaginationMeta, *http.Response, error) {
+	u, err := addOptions("v2/escalations", opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var result struct {
+		Escalations    []*Escalation   `json:"escalations"`
+		PaginationMeta *PaginationMeta `json:"pagination_meta,omitempty"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, nil, resp, err
+	}
+
+	return result.Escalations, result.PaginationMeta, resp, nil
+}
@@ -0,0 +1,413 @@
+package incidentio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestEscalationsService_GetPath(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/escalation_paths/01HR9P6Q2S0CWD9JZQYB7K1N3A", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Authorization", "Bearer test-key")
+
+		response := `{
+			"escalation_path": {
+				"id": "01HR9P6Q2S0CWD9JZQYB7K1N3A",
+				"name": "Database on-call",
+				"path": [
+					{
+						"id": "node-1",
+						"type": "if_else",
+						"if_else": {
+							"conditions": [
+								{
+									"subject": {"label": "Escalation → Working hours", "reference": "escalation.working_hours"},
+									"operation": {"label": "is active", "value": "is_active"},
+									"param_bindings": [{"value": {"literal": "uk-hours"}}]
+								}
+							],
+							"then_path": [
+								{
+									"id": "node-2",
+									"type": "level",
+									"level": {
+										"targets": [{"id": "01HR9P6Q2S0CWD9JZQYB7K1N3B", "type": "schedule", "urgency": "high", "schedule_mode": "currently_on_call"}],
+										"time_to_ack_seconds": 300
+									}
+								}
+							],
+							"else_path": [
+								{
+									"id": "node-3",
+									"type": "level",
+									"level": {
+										"targets": [{"id": "01HR9P6Q2S0CWD9JZQYB7K1N3C", "type": "user", "urgency": "low"}],
+										"time_to_ack_seconds": 900
+									}
+								}
+							]
+						}
+					},
+					{"id": "node-4", "type": "repeat", "repeat": {"repeat_times": 2, "to_node": "node-1"}}
+				],
+				"working_hours": [
+					{
+						"id": "uk-hours",
+						"name": "UK office hours",
+						"timezone": "Europe/London",
+						"weekday_intervals": [{"weekday": "monday", "start_time": "09:00", "end_time": "17:30"}]
+					}
+				]
+			}
+		}`
+
+		_, _ = fmt.Fprint(w, response)
+	})
+
+	ctx := context.Background()
+	path, _, err := client.Escalations.GetPath(ctx, "01HR9P6Q2S0CWD9JZQYB7K1N3A")
+	if err != nil {
+		t.Errorf("Escalations.GetPath returned error: %v", err)
+	}
+
+	expected := &EscalationPath{
+		ID:   "01HR9P6Q2S0CWD9JZQYB7K1N3A",
+		Name: "Database on-call",
+		Path: []EscalationPathNode{
+			{
+				ID:   "node-1",
+				Type: EscalationPathNodeTypeIfElse,
+				IfElse: &EscalationPathNodeIfElse{
+					Conditions: []Condition{
+						{
+							Subject:       &ConditionSubject{Label: "Escalation → Working hours", Reference: "escalation.working_hours"},
+							Operation:     &ConditionOperation{Label: "is active", Value: "is_active"},
+							ParamBindings: []ParamBinding{{Value: &ParamBindingValue{Literal: "uk-hours"}}},
+						},
+					},
+					ThenPath: []EscalationPathNode{
+						{
+							ID:   "node-2",
+							Type: EscalationPathNodeTypeLevel,
+							Level: &EscalationPathNodeLevel{
+								Targets: []EscalationPathTarget{
+									{ID: "01HR9P6Q2S0CWD9JZQYB7K1N3B", Type: EscalationPathTargetTypeSchedule, Urgency: EscalationPathTargetUrgencyHigh, ScheduleMode: "currently_on_call"},
+								},
+								TimeToAckSeconds: 300,
+							},
+						},
+					},
+					ElsePath: []EscalationPathNode{
+						{
+							ID:   "node-3",
+							Type: EscalationPathNodeTypeLevel,
+							Level: &EscalationPathNodeLevel{
+								Targets: []EscalationPathTarget{
+									{ID: "01HR9P6Q2S0CWD9JZQYB7K1N3C", Type: EscalationPathTargetTypeUser, Urgency: EscalationPathTargetUrgencyLow},
+								},
+								TimeToAckSeconds: 900,
+							},
+						},
+					},
+				},
+			},
+			{
+				ID:     "node-4",
+				Type:   EscalationPathNodeTypeRepeat,
+				Repeat: &EscalationPathNodeRepeat{RepeatTimes: 2, ToNode: "node-1"},
+			},
+		},
+		WorkingHours: []WorkingHours{
+			{
+				ID:               "uk-hours",
+				Name:             "UK office hours",
+				Timezone:         "Europe/London",
+				WeekdayIntervals: []WeekdayInterval{{Weekday: "monday", StartTime: "09:00", EndTime: "17:30"}},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(path, expected) {
+		t.Errorf("Escalations.GetPath returned %+v, want %+v", path, expected)
+	}
+}
+
+func TestEscalationsService_CreatePath(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CreateEscalationPathOptions{
+		Name: "Database on-call",
+		Path: []EscalationPathNodePayload{
+			{
+				Type: EscalationPathNodeTypeLevel,
+				Level: &EscalationPathNodeLevel{
+					Targets: []EscalationPathTarget{
+						{ID: "01HR9P6Q2S0CWD9JZQYB7K1N3B", Type: EscalationPathTargetTypeSchedule, Urgency: EscalationPathTargetUrgencyHigh},
+					},
+					TimeToAckSeconds: 300,
+				},
+			},
+			{
+				Type: EscalationPathNodeTypeIfElse,
+				IfElse: &EscalationPathNodeIfElsePayload{
+					Conditions: []ConditionPayload{
+						{
+							Subject:       "escalation.working_hours",
+							Operation:     "is_active",
+							ParamBindings: []ParamBinding{{Value: &ParamBindingValue{Literal: "uk-hours"}}},
+						},
+					},
+					ThenPath: []EscalationPathNodePayload{
+						{
+							Type: EscalationPathNodeTypeLevel,
+							Level: &EscalationPathNodeLevel{
+								Targets: []EscalationPathTarget{
+									{ID: "01HR9P6Q2S0CWD9JZQYB7K1N3C", Type: EscalationPathTargetTypeUser, Urgency: EscalationPathTargetUrgencyHigh},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		WorkingHours: []WorkingHours{
+			{
+				ID:               "uk-hours",
+				Name:             "UK office hours",
+				Timezone:         "Europe/London",
+				WeekdayIntervals: []WeekdayInterval{{Weekday: "monday", StartTime: "09:00", EndTime: "17:30"}},
+			},
+		},
+	}
+
+	mux.HandleFunc("/v2/escalation_paths", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", "application/json")
+
+		var received CreateEscalationPathOptions
+		err := json.NewDecoder(r.Body).Decode(&received)
+		if err != nil {
+			t.Errorf("error decoding request body: %v", err)
+		}
+
+		if !reflect.DeepEqual(received, *input) {
+			t.Errorf("Request body = %+v, want %+v", received, *input)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `{"escalation_path": {"id": "01HR9P6Q2S0CWD9JZQYB7K1N3A", "name": "Database on-call", "path": []}}`)
+	})
+
+	ctx := context.Background()
+	path, resp, err := client.Escalations.CreatePath(ctx, input)
+	if err != nil {
+		t.Errorf("Escalations.CreatePath returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Errorf("Escalations.CreatePath returned status %d, want %d", resp.StatusCode, http.StatusCreated)
+	}
+
+	if path.ID != "01HR9P6Q2S0CWD9JZQYB7K1N3A" {
+		t.Errorf("Escalations.CreatePath returned ID %s, want 01HR9P6Q2S0CWD9JZQYB7K1N3A", path.ID)
+	}
+}
+
+func TestEscalationsService_UpdatePath(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &UpdateEscalationPathOptions{
+		Name: "Database on-call (primary)",
+		Path: []EscalationPathNodePayload{
+			{
+				ID:   "node-1",
+				Type: EscalationPathNodeTypeLevel,
+				Level: &EscalationPathNodeLevel{
+					Targets: []EscalationPathTarget{
+						{ID: "01HR9P6Q2S0CWD9JZQYB7K1N3B", Type: EscalationPathTargetTypeSchedule, Urgency: EscalationPathTargetUrgencyHigh},
+					},
+				},
+			},
+		},
+	}
+
+	mux.HandleFunc("/v2/escalation_paths/01HR9P6Q2S0CWD9JZQYB7K1N3A", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+
+		var received UpdateEscalationPathOptions
+		err := json.NewDecoder(r.Body).Decode(&received)
+		if err != nil {
+			t.Errorf("error decoding request body: %v", err)
+		}
+
+		if !reflect.DeepEqual(received, *input) {
+			t.Errorf("Request body = %+v, want %+v", received, *input)
+		}
+
+		_, _ = fmt.Fprint(w, `{"escalation_path": {"id": "01HR9P6Q2S0CWD9JZQYB7K1N3A", "name": "Database on-call (primary)", "path": []}}`)
+	})
+
+	ctx := context.Background()
+	path, _, err := client.Escalations.UpdatePath(ctx, "01HR9P6Q2S0CWD9JZQYB7K1N3A", input)
+	if err != nil {
+		t.Errorf("Escalations.UpdatePath returned error: %v", err)
+	}
+
+	if path.Name != "Database on-call (primary)" {
+		t.Errorf("Escalations.UpdatePath returned Name %s, want Database on-call (primary)", path.Name)
+	}
+}
+
+func TestEscalationsService_DeletePath(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/escalation_paths/01HR9P6Q2S0CWD9JZQYB7K1N3A", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	resp, err := client.Escalations.DeletePath(ctx, "01HR9P6Q2S0CWD9JZQYB7K1N3A")
+	if err != nil {
+		t.Errorf("Escalations.DeletePath returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Escalations.DeletePath returned status %d, want %d", resp.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestEscalationsService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CreateEscalationOptions{
+		IdempotencyKey:   "chatops-1234",
+		Title:            "Replication lag on db-primary",
+		EscalationPathID: "01HR9P6Q2S0CWD9JZQYB7K1N3A",
+	}
+
+	mux.HandleFunc("/v2/escalations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		var received CreateEscalationOptions
+		err := json.NewDecoder(r.Body).Decode(&received)
+		if err != nil {
+			t.Errorf("error decoding request body: %v", err)
+		}
+
+		if !reflect.DeepEqual(received, *input) {
+			t.Errorf("Request body = %+v, want %+v", received, *input)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `{
+			"escalation": {
+				"id": "01HRA1B2C3D4E5F6G7H8J9K0LM",
+				"title": "Replication lag on db-primary",
+				"status": "pending",
+				"escalation_path_id": "01HR9P6Q2S0CWD9JZQYB7K1N3A",
+				"priority": {"name": "High"},
+				"created_at": "2024-03-05T09:00:00Z",
+				"updated_at": "2024-03-05T09:00:00Z"
+			}
+		}`)
+	})
+
+	ctx := context.Background()
+	escalation, _, err := client.Escalations.Create(ctx, input)
+	if err != nil {
+		t.Errorf("Escalations.Create returned error: %v", err)
+	}
+
+	expected := &Escalation{
+		ID:               "01HRA1B2C3D4E5F6G7H8J9K0LM",
+		Title:            "Replication lag on db-primary",
+		Status:           EscalationStatusPending,
+		EscalationPathID: "01HR9P6Q2S0CWD9JZQYB7K1N3A",
+		Priority:         &EscalationPriority{Name: "High"},
+		CreatedAt:        Timestamp{parseTime("2024-03-05T09:00:00Z")},
+		UpdatedAt:        Timestamp{parseTime("2024-03-05T09:00:00Z")},
+	}
+
+	if !reflect.DeepEqual(escalation, expected) {
+		t.Errorf("Escalations.Create returned %+v, want %+v", escalation, expected)
+	}
+}
+
+func TestEscalationsService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/escalations/01HRA1B2C3D4E5F6G7H8J9K0LM", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{"escalation": {"id": "01HRA1B2C3D4E5F6G7H8J9K0LM", "title": "Replication lag on db-primary", "status": "acked"}}`)
+	})
+
+	ctx := context.Background()
+	escalation, _, err := client.Escalations.Get(ctx, "01HRA1B2C3D4E5F6G7H8J9K0LM")
+	if err != nil {
+		t.Errorf("Escalations.Get returned error: %v", err)
+	}
+
+	if escalation.Status != EscalationStatusAcked {
+		t.Errorf("Escalations.Get returned Status %s, want %s", escalation.Status, EscalationStatusAcked)
+	}
+}
+
+func TestEscalationsService_ListAll(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/v2/escalations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+
+		if got := r.URL.Query().Get("escalation_path_id"); got != "01HR9P6Q2S0CWD9JZQYB7K1N3A" {
+			t.Errorf("escalation_path_id = %q, want %q", got, "01HR9P6Q2S0CWD9JZQYB7K1N3A")
+		}
+
+		switch after := r.URL.Query().Get("after"); after {
+		case "":
+			_, _ = fmt.Fprint(w, `{
+				"escalations": [{"id": "escalation-1", "status": "triggered"}],
+				"pagination_meta": {"after": "escalation-1", "page_size": 1}
+			}`)
+		case "escalation-1":
+			_, _ = fmt.Fprint(w, `{
+				"escalations": [{"id": "escalation-2", "status": "resolved"}],
+				"pagination_meta": {"page_size": 1}
+			}`)
+		default:
+			t.Errorf("unexpected after cursor %q", after)
+		}
+	})
+
+	ctx := context.Background()
+	escalations, _, err := client.Escalations.ListAll(ctx, &ListEscalationsOptions{
+		EscalationPathID: "01HR9P6Q2S0CWD9JZQYB7K1N3A",
+		ListOptions:      ListOptions{PageSize: 1},
+	})
+	if err != nil {
+		t.Errorf("Escalations.ListAll returned error: %v", err)
+	}
+
+	if calls != 2 {
+		t.Errorf("Escalations.ListAll made %d requests, want 2", calls)
+	}
+
+	if len(escalations) != 2 || escalations[0].ID != "escalation-1" || escalations[1].ID != "escalation-2" {
+		t.Errorf("Escalations.ListAll returned %+v, want escalation-1 and escalation-2", escalations)
+	}
+}
@@ -0,0 +1,135 @@
+package incidentio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Follow-up statuses.
+const (
+	FollowUpStatusOutstanding = "outstanding"
+	FollowUpStatusCompleted   = "completed"
+	FollowUpStatusDeleted     = "deleted"
+	FollowUpStatusNotDoing    = "not_doing"
+)
+
+// FollowUpsService handles communication with the follow-up related methods.
+type FollowUpsService struct {
+	client *Client
+}
+
+// FollowUp represents a post-incident follow-up in Incident.io.
+type FollowUp struct {
+	ID                     string            `json:"id"`
+	IncidentID             string            `json:"incident_id"`
+	Title                  string            `json:"title"`
+	Description            string            `json:"description,omitempty"`
+	Status                 string            `json:"status"`
+	Assignee               *User             `json:"assignee,omitempty"`
+	Priority               *FollowUpPriority `json:"priority,omitempty"`
+	ExternalIssueReference *ExternalResource `json:"external_issue_reference,omitempty"`
+	CompletedAt            *Timestamp        `json:"completed_at,omitempty"`
+	CreatedAt              Timestamp         `json:"created_at"`
+	UpdatedAt              Timestamp         `json:"updated_at"`
+}
+
+// FollowUpPriority represents the priority of a follow-up.
+type FollowUpPriority struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Rank        int    `json:"rank"`
+}
+
+// ListFollowUpsOptions represents options for listing follow-ups.
+type ListFollowUpsOptions struct {
+	// IncidentID restricts the results to follow-ups of a single incident.
+	IncidentID string `url:"incident_id,omitempty"`
+	// IncidentMode restricts the results to incidents of the given mode.
+	IncidentMode string `url:"incident_mode,omitempty"`
+	// Status restricts the results to follow-ups with the given status. The
+	// API does not support this filter, so it is applied client-side.
+	Status string `url:"-"`
+}
+
+// List returns a list of follow-ups.
+func (s *FollowUpsService) List(ctx context.Context, opts *ListFollowUpsOptions) ([]*FollowUp, *http.Response, error) {
+	u, err := addOptions("v2/follow_ups", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		FollowUps []*FollowUp `json:"follow_ups"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if opts == nil || opts.Status == "" {
+		return result.FollowUps, resp, nil
+	}
+
+	followUps := make([]*FollowUp, 0, len(result.FollowUps))
+	for _, f := range result.FollowUps {
+		if f.Status == opts.Status {
+			followUps = append(followUps, f)
+		}
+	}
+
+	return followUps, resp, nil
+}
+
+// Get returns a single follow-up.
+func (s *FollowUpsService) Get(ctx context.Context, id string) (*FollowUp, *http.Response, error) {
+	u := fmt.Sprintf("v2/follow_ups/%s", id)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		FollowUp *FollowUp `json:"follow_up"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.FollowUp, resp, nil
+}
+
+// OverdueFollowUps returns the follow-ups of incident that are still
+// outstanding more than grace after the incident was closed. Nothing is
+// overdue while the incident is open.
+func OverdueFollowUps(incident *Incident, followUps []*FollowUp, grace time.Duration, now time.Time) []*FollowUp {
+	if incident == nil || incident.ClosedAt == nil {
+		return nil
+	}
+
+	deadline := incident.ClosedAt.Add(grace)
+	if !now.After(deadline) {
+		return nil
+	}
+
+	var overdue []*FollowUp
+	for _, f := range followUps {
+		if f.IncidentID != "" && f.IncidentID != incident.ID {
+			continue
+		}
+		if f.Status == FollowUpStatusOutstanding {
+			overdue = append(overdue, f)
+		}
+	}
+
+	return overdue
+}
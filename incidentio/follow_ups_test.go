@@ -0,0 +1,180 @@
+package incidentio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFollowUpsService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/follow_ups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Authorization", "Bearer test-key")
+
+		if got := r.URL.Query().Get("incident_id"); got != "01FDAG4SAP5TYPT98WGR2N7W91" {
+			t.Errorf("incident_id = %q, want %q", got, "01FDAG4SAP5TYPT98WGR2N7W91")
+		}
+		if r.URL.Query().Has("status") {
+			t.Error("status must not be sent to the API")
+		}
+
+		response := `{
+			"follow_ups": [
+				{
+					"id": "01FCNDV6P870EA6S7TK1DSYDG0",
+					"incident_id": "01FDAG4SAP5TYPT98WGR2N7W91",
+					"title": "Add connection pool alerting",
+					"status": "outstanding",
+					"priority": {
+						"id": "01GBSQF3FHF7FWZQNWGHAVQ804",
+						"name": "High",
+						"rank": 10
+					},
+					"external_issue_reference": {
+						"external_id": "ENG-123",
+						"display_name": "Add connection pool alerting",
+						"provider": "linear",
+						"permalink": "https://linear.app/example/issue/ENG-123"
+					},
+					"created_at": "2021-08-17T13:28:57.801578Z",
+					"updated_at": "2021-08-17T13:28:57.801578Z"
+				},
+				{
+					"id": "01FCNDV6P870EA6S7TK1DSYDG1",
+					"incident_id": "01FDAG4SAP5TYPT98WGR2N7W91",
+					"title": "Document failover",
+					"status": "completed",
+					"created_at": "2021-08-17T13:28:57.801578Z",
+					"updated_at": "2021-08-17T13:28:57.801578Z"
+				}
+			]
+		}`
+
+		_, _ = fmt.Fprint(w, response)
+	})
+
+	ctx := context.Background()
+	followUps, _, err := client.FollowUps.List(ctx, &ListFollowUpsOptions{
+		IncidentID: "01FDAG4SAP5TYPT98WGR2N7W91",
+		Status:     FollowUpStatusOutstanding,
+	})
+	if err != nil {
+		t.Errorf("FollowUps.List returned error: %v", err)
+	}
+
+	expected := []*FollowUp{
+		{
+			ID:         "01FCNDV6P870EA6S7TK1DSYDG0",
+			IncidentID: "01FDAG4SAP5TYPT98WGR2N7W91",
+			Title:      "Add connection pool alerting",
+			Status:     FollowUpStatusOutstanding,
+			Priority: &FollowUpPriority{
+				ID:   "01GBSQF3FHF7FWZQNWGHAVQ804",
+				Name: "High",
+				Rank: 10,
+			},
+			ExternalIssueReference: &ExternalResource{
+				ExternalID:  "ENG-123",
+				DisplayName: "Add connection pool alerting",
+				Provider:    "linear",
+				Permalink:   "https://linear.app/example/issue/ENG-123",
+			},
+			CreatedAt: Timestamp{parseTime("2021-08-17T13:28:57.801578Z")},
+			UpdatedAt: Timestamp{parseTime("2021-08-17T13:28:57.801578Z")},
+		},
+	}
+
+	if !reflect.DeepEqual(followUps, expected) {
+		t.Errorf("FollowUps.List returned %+v, want %+v", followUps, expected)
+	}
+}
+
+func TestFollowUpsService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/follow_ups/01FCNDV6P870EA6S7TK1DSYDG0", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		response := `{
+			"follow_up": {
+				"id": "01FCNDV6P870EA6S7TK1DSYDG0",
+				"incident_id": "01FDAG4SAP5TYPT98WGR2N7W91",
+				"title": "Add connection pool alerting",
+				"status": "completed",
+				"completed_at": "2021-08-18T13:28:57.801578Z",
+				"created_at": "2021-08-17T13:28:57.801578Z",
+				"updated_at": "2021-08-18T13:28:57.801578Z"
+			}
+		}`
+
+		_, _ = fmt.Fprint(w, response)
+	})
+
+	ctx := context.Background()
+	followUp, _, err := client.FollowUps.Get(ctx, "01FCNDV6P870EA6S7TK1DSYDG0")
+	if err != nil {
+		t.Errorf("FollowUps.Get returned error: %v", err)
+	}
+
+	if followUp.Title != "Add connection pool alerting" {
+		t.Errorf("FollowUps.Get returned Title %s, want %s", followUp.Title, "Add connection pool alerting")
+	}
+
+	if followUp.CompletedAt == nil {
+		t.Error("FollowUps.Get returned nil CompletedAt, want timestamp")
+	}
+}
+
+func TestOverdueFollowUps(t *testing.T) {
+	closedAt := Timestamp{parseTime("2021-08-17T13:00:00Z")}
+	incident := &Incident{ID: "incident-1", ClosedAt: &closedAt}
+
+	followUps := []*FollowUp{
+		{ID: "open", IncidentID: "incident-1", Status: FollowUpStatusOutstanding},
+		{ID: "done", IncidentID: "incident-1", Status: FollowUpStatusCompleted},
+		{ID: "other", IncidentID: "incident-2", Status: FollowUpStatusOutstanding},
+	}
+
+	tests := []struct {
+		name     string
+		incident *Incident
+		now      time.Time
+		want     []string
+	}{
+		{
+			name:     "open incident",
+			incident: &Incident{ID: "incident-1"},
+			now:      parseTime("2021-09-17T13:00:00Z"),
+		},
+		{
+			name:     "within grace period",
+			incident: incident,
+			now:      parseTime("2021-08-20T13:00:00Z"),
+		},
+		{
+			name:     "past grace period",
+			incident: incident,
+			now:      parseTime("2021-08-25T13:00:00Z"),
+			want:     []string{"open"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, f := range OverdueFollowUps(tt.incident, followUps, 7*24*time.Hour, tt.now) {
+				got = append(got, f.ID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("OverdueFollowUps() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package incidentio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Incident attachment resource types.
+const (
+	ResourceTypePagerDutyIncident   = "pager_duty_incident"
+	ResourceTypeOpsgenieAlert       = "opsgenie_alert"
+	ResourceTypeDatadogMonitorAlert = "datadog_monitor_alert"
+	ResourceTypeGitHubPullRequest   = "github_pull_request"
+	ResourceTypeGitLabMergeRequest  = "gitlab_merge_request"
+	ResourceTypeSentryIssue         = "sentry_issue"
+	ResourceTypeJiraIssue           = "jira_issue"
+	ResourceTypeZendeskTicket       = "zendesk_ticket"
+	ResourceTypeStatuspageIncident  = "statuspage_incident"
+)
+
+// IncidentAttachmentsService handles communication with the incident
+// attachment related methods.
+type IncidentAttachmentsService struct {
+	client *Client
+}
+
+// IncidentAttachment represents an external resource, such as a pull request
+// or an error tracker issue, attached to an incident.
+type IncidentAttachment struct {
+	ID         string            `json:"id"`
+	IncidentID string            `json:"incident_id"`
+	Resource   *ExternalResource `json:"resource"`
+}
+
+// ListIncidentAttachmentsOptions represents options for listing incident
+// attachments.
+type ListIncidentAttachmentsOptions struct {
+	IncidentID   string `url:"incident_id,omitempty"`
+	ExternalID   string `url:"external_id,omitempty"`
+	ResourceType string `url:"resource_type,omitempty"`
+}
+
+// CreateIncidentAttachmentOptions represents options for attaching an
+// external resource to an incident.
+type CreateIncidentAttachmentOptions struct {
+	IncidentID string                            `json:"incident_id"`
+	Resource   IncidentAttachmentResourcePayload `json:"resource"`
+}
+
+// IncidentAttachmentResourcePayload identifies the external resource to
+// attach. incident.io looks up the rest of its details from the provider.
+type IncidentAttachmentResourcePayload struct {
+	ExternalID   string `json:"external_id"`
+	ResourceType string `json:"resource_type"`
+}
+
+// List returns a list of incident attachments.
+func (s *IncidentAttachmentsService) List(ctx context.Context, opts *ListIncidentAttachmentsOptions) ([]*IncidentAttachment, *http.Response, error) {
+	u, err := addOptions("v1/incident_attachments", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		IncidentAttachments []*IncidentAttachment `json:"incident_attachments"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.IncidentAttachments, resp, nil
+}
+
+// Create attaches an external resource to an incident.
+func (s *IncidentAttachmentsService) Create(ctx context.Context, opts *CreateIncidentAttachmentOptions) (*IncidentAttachment, *http.Response, error) {
+	u := "v1/incident_attachments"
+
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		IncidentAttachment *IncidentAttachment `json:"incident_attachment"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.IncidentAttachment, resp, nil
+}
+
+// Delete removes an attachment from its incident.
+func (s *IncidentAttachmentsService) Delete(ctx context.Context, id string) (*http.Response, error) {
+	u := fmt.Sprintf("v1/incident_attachments/%s", id)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,140 @@
+package incidentio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestIncidentAttachmentsService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/incident_attachments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Authorization", "Bearer test-key")
+		if got := r.URL.Query().Get("incident_id"); got != "incident-1" {
+			t.Errorf("incident_id = %q, want incident-1", got)
+		}
+		if got := r.URL.Query().Get("resource_type"); got != ResourceTypeGitHubPullRequest {
+			t.Errorf("resource_type = %q, want %s", got, ResourceTypeGitHubPullRequest)
+		}
+
+		_, _ = fmt.Fprint(w, `{
+			"incident_attachments": [
+				{
+					"id": "attachment-1",
+					"incident_id": "incident-1",
+					"resource": {
+						"external_id": "1234",
+						"permalink": "https://github.com/acme/api/pull/1234",
+						"resource_type": "github_pull_request",
+						"title": "Bump connection pool size"
+					}
+				}
+			]
+		}`)
+	})
+
+	ctx := context.Background()
+	attachments, _, err := client.IncidentAttachments.List(ctx, &ListIncidentAttachmentsOptions{
+		IncidentID:   "incident-1",
+		ResourceType: ResourceTypeGitHubPullRequest,
+	})
+	if err != nil {
+		t.Errorf("IncidentAttachments.List returned error: %v", err)
+	}
+
+	expected := []*IncidentAttachment{
+		{
+			ID:         "attachment-1",
+			IncidentID: "incident-1",
+			Resource: &ExternalResource{
+				ExternalID:   "1234",
+				Permalink:    "https://github.com/acme/api/pull/1234",
+				ResourceType: ResourceTypeGitHubPullRequest,
+				Title:        "Bump connection pool size",
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(attachments, expected) {
+		t.Errorf("IncidentAttachments.List returned %+v, expected %+v", attachments, expected)
+	}
+}
+
+func TestIncidentAttachmentsService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CreateIncidentAttachmentOptions{
+		IncidentID: "incident-1",
+		Resource: IncidentAttachmentResourcePayload{
+			ExternalID:   "1234",
+			ResourceType: ResourceTypeGitHubPullRequest,
+		},
+	}
+
+	mux.HandleFunc("/v1/incident_attachments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		var got CreateIncidentAttachmentOptions
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("error decoding request body: %v", err)
+		}
+		if !reflect.DeepEqual(&got, input) {
+			t.Errorf("Request body = %+v, expected %+v", got, input)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `{
+			"incident_attachment": {
+				"id": "attachment-1",
+				"incident_id": "incident-1",
+				"resource": {"external_id": "1234", "resource_type": "github_pull_request"}
+			}
+		}`)
+	})
+
+	ctx := context.Background()
+	attachment, _, err := client.IncidentAttachments.Create(ctx, input)
+	if err != nil {
+		t.Errorf("IncidentAttachments.Create returned error: %v", err)
+	}
+
+	expected := &IncidentAttachment{
+		ID:         "attachment-1",
+		IncidentID: "incident-1",
+		Resource: &ExternalResource{
+			ExternalID:   "1234",
+			ResourceType: ResourceTypeGitHubPullRequest,
+		},
+	}
+
+	if !reflect.DeepEqual(attachment, expected) {
+		t.Errorf("IncidentAttachments.Create returned %+v, expected %+v", attachment, expected)
+	}
+}
+
+func TestIncidentAttachmentsService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/incident_attachments/attachment-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	resp, err := client.IncidentAttachments.Delete(ctx, "attachment-1")
+	if err != nil {
+		t.Errorf("IncidentAttachments.Delete returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("IncidentAttachments.Delete returned status %d, want %d", resp.StatusCode, http.StatusNoContent)
+	}
+}
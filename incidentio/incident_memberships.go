@@ -0,0 +1,116 @@
+package incidentio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// IncidentMembershipsService handles communication with the incident
+// membership related methods.
+type IncidentMembershipsService struct {
+	client *Client
+}
+
+// IncidentMembership grants a user access to a private incident.
+type IncidentMembership struct {
+	ID         string    `json:"id"`
+	IncidentID string    `json:"incident_id"`
+	User       *User     `json:"user"`
+	CreatedAt  Timestamp `json:"created_at"`
+	UpdatedAt  Timestamp `json:"updated_at"`
+}
+
+// ListIncidentMembershipsOptions represents options for listing incident
+// memberships.
+type ListIncidentMembershipsOptions struct {
+	IncidentID string `url:"incident_id,omitempty"`
+}
+
+// CreateIncidentMembershipOptions represents options for granting a user
+// access to a private incident.
+type CreateIncidentMembershipOptions struct {
+	IncidentID string `json:"incident_id"`
+	UserID     string `json:"user_id"`
+}
+
+// RevokeIncidentMembershipOptions represents options for revoking a user's
+// access to a private incident.
+type RevokeIncidentMembershipOptions struct {
+	IncidentID string `json:"incident_id"`
+	UserID     string `json:"user_id"`
+}
+
+// List returns the memberships of private incidents.
+func (s *IncidentMembershipsService) List(ctx context.Context, opts *ListIncidentMembershipsOptions) ([]*IncidentMembership, *http.Response, error) {
+	u, err := addOptions("v1/incident_memberships", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		IncidentMemberships []*IncidentMembership `json:"incident_memberships"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.IncidentMemberships, resp, nil
+}
+
+// Create grants a user access to a private incident.
+func (s *IncidentMembershipsService) Create(ctx context.Context, opts *CreateIncidentMembershipOptions) (*IncidentMembership, *http.Response, error) {
+	u := "v1/incident_memberships"
+
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		IncidentMembership *IncidentMembership `json:"incident_membership"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.IncidentMembership, resp, nil
+}
+
+// Grant gives each of the users access to a private incident. It stops at
+// the first error and returns the memberships created until then.
+func (s *IncidentMembershipsService) Grant(ctx context.Context, incidentID string, userIDs ...string) ([]*IncidentMembership, *http.Response, error) {
+	var (
+		memberships []*IncidentMembership
+		resp        *http.Response
+	)
+	for _, userID := range userIDs {
+		membership, r, err := s.Create(ctx, &CreateIncidentMembershipOptions{IncidentID: incidentID, UserID: userID})
+		resp = r
+		if err != nil {
+			return memberships, resp, fmt.Errorf("granting %s access: %w", userID, err)
+		}
+		memberships = append(memberships, membership)
+	}
+
+	return memberships, resp, nil
+}
+
+// Revoke removes a user's access to a private incident.
+func (s *IncidentMembershipsService) Revoke(ctx context.Context, opts *RevokeIncidentMembershipOptions) (*http.Response, error) {
+	u := "v1/incident_memberships/actions/revoke"
+
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
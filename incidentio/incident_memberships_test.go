@@ -0,0 +1,170 @@
+package incidentio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestIncidentMembershipsService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/incident_memberships", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Authorization", "Bearer test-key")
+		if got := r.URL.Query().Get("incident_id"); got != "incident-1" {
+			t.Errorf("incident_id = %q, want incident-1", got)
+		}
+
+		_, _ = fmt.Fprint(w, `{
+			"incident_memberships": [
+				{
+					"id": "membership-1",
+					"incident_id": "incident-1",
+					"user": {"id": "user-1", "name": "Alice"},
+					"created_at": "2021-08-17T13:28:57Z",
+					"updated_at": "2021-08-17T13:28:57Z"
+				}
+			]
+		}`)
+	})
+
+	ctx := context.Background()
+	memberships, _, err := client.IncidentMemberships.List(ctx, &ListIncidentMembershipsOptions{IncidentID: "incident-1"})
+	if err != nil {
+		t.Errorf("IncidentMemberships.List returned error: %v", err)
+	}
+
+	expected := []*IncidentMembership{
+		{
+			ID:         "membership-1",
+			IncidentID: "incident-1",
+			User:       &User{ID: "user-1", Name: "Alice"},
+			CreatedAt:  Timestamp{parseTime("2021-08-17T13:28:57Z")},
+			UpdatedAt:  Timestamp{parseTime("2021-08-17T13:28:57Z")},
+		},
+	}
+
+	if !reflect.DeepEqual(memberships, expected) {
+		t.Errorf("IncidentMemberships.List returned %+v, expected %+v", memberships, expected)
+	}
+}
+
+func TestIncidentMembershipsService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CreateIncidentMembershipOptions{IncidentID: "incident-1", UserID: "user-1"}
+
+	mux.HandleFunc("/v1/incident_memberships", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		var got CreateIncidentMembershipOptions
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("error decoding request body: %v", err)
+		}
+		if !reflect.DeepEqual(&got, input) {
+			t.Errorf("Request body = %+v, expected %+v", got, input)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `{
+			"incident_membership": {
+				"id": "membership-1",
+				"incident_id": "incident-1",
+				"user": {"id": "user-1", "name": "Alice"},
+				"created_at": "2021-08-17T13:28:57Z",
+				"updated_at": "2021-08-17T13:28:57Z"
+			}
+		}`)
+	})
+
+	ctx := context.Background()
+	membership, _, err := client.IncidentMemberships.Create(ctx, input)
+	if err != nil {
+		t.Errorf("IncidentMemberships.Create returned error: %v", err)
+	}
+
+	expected := &IncidentMembership{
+		ID:         "membership-1",
+		IncidentID: "incident-1",
+		User:       &User{ID: "user-1", Name: "Alice"},
+		CreatedAt:  Timestamp{parseTime("2021-08-17T13:28:57Z")},
+		UpdatedAt:  Timestamp{parseTime("2021-08-17T13:28:57Z")},
+	}
+
+	if !reflect.DeepEqual(membership, expected) {
+		t.Errorf("IncidentMemberships.Create returned %+v, expected %+v", membership, expected)
+	}
+}
+
+func TestIncidentMembershipsService_Grant(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var granted []string
+	mux.HandleFunc("/v1/incident_memberships", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		var got CreateIncidentMembershipOptions
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("error decoding request body: %v", err)
+		}
+		if got.UserID == "user-missing" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = fmt.Fprint(w, `{"type": "not_found", "status": 404, "detail": "user not found"}`)
+			return
+		}
+		granted = append(granted, got.UserID)
+
+		_, _ = fmt.Fprintf(w, `{"incident_membership": {"id": "membership-%s", "incident_id": %q}}`, got.UserID, got.IncidentID)
+	})
+
+	ctx := context.Background()
+	memberships, _, err := client.IncidentMemberships.Grant(ctx, "incident-1", "user-1", "user-missing", "user-2")
+	if err == nil {
+		t.Fatal("IncidentMemberships.Grant returned no error for a missing user")
+	}
+
+	if len(memberships) != 1 || memberships[0].ID != "membership-user-1" {
+		t.Errorf("IncidentMemberships.Grant returned %+v, expected the membership of user-1", memberships)
+	}
+	if !reflect.DeepEqual(granted, []string{"user-1"}) {
+		t.Errorf("IncidentMemberships.Grant granted %v, expected it to stop at the error", granted)
+	}
+}
+
+func TestIncidentMembershipsService_Revoke(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &RevokeIncidentMembershipOptions{IncidentID: "incident-1", UserID: "user-1"}
+
+	mux.HandleFunc("/v1/incident_memberships/actions/revoke", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		var got RevokeIncidentMembershipOptions
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("error decoding request body: %v", err)
+		}
+		if !reflect.DeepEqual(&got, input) {
+			t.Errorf("Request body = %+v, expected %+v", got, input)
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	ctx := context.Background()
+	resp, err := client.IncidentMemberships.Revoke(ctx, input)
+	if err != nil {
+		t.Errorf("IncidentMemberships.Revoke returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusAccepted {
+		t.Errorf("IncidentMemberships.Revoke returned status %d, want %d", resp.StatusCode, http.StatusAccepted)
+	}
+}
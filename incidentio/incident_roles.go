@@ -2,9 +2,17 @@ package incidentio
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 )
 
+// Incident role types.
+const (
+	IncidentRoleTypeLead     = "lead"
+	IncidentRoleTypeReporter = "reporter"
+	IncidentRoleTypeCustom   = "custom"
+)
+
 // CreateRoleAssignment represents the payload for creating a role assignment in an incident.
 type CreateRoleAssignment struct {
 	IncidentRoleID string `json:"incident_role_id"`
@@ -29,12 +37,15 @@ type Severity struct {
 
 // IncidentRole represents a role that can be assigned to users in an incident.
 type IncidentRole struct {
-	ID          string    `json:"id"`
-	Name        string    `json:"name"`
-	Description string    `json:"description"`
-	Required    bool      `json:"required"`
-	CreatedAt   Timestamp `json:"created_at"`
-	UpdatedAt   Timestamp `json:"updated_at"`
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	Description  string    `json:"description"`
+	Instructions string    `json:"instructions,omitempty"`
+	Shortform    string    `json:"shortform,omitempty"`
+	RoleType     string    `json:"role_type,omitempty"`
+	Required     bool      `json:"required"`
+	CreatedAt    Timestamp `json:"created_at"`
+	UpdatedAt    Timestamp `json:"updated_at"`
 }
 
 // IncidentRolesService handles communication with the incident role related methods.
@@ -42,6 +53,24 @@ type IncidentRolesService struct {
 	client *Client
 }
 
+// CreateIncidentRoleOptions represents options for creating an incident role.
+type CreateIncidentRoleOptions struct {
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	Instructions string `json:"instructions"`
+	Shortform    string `json:"shortform"`
+	Required     bool   `json:"required,omitempty"`
+}
+
+// UpdateIncidentRoleOptions represents options for updating an incident role.
+type UpdateIncidentRoleOptions struct {
+	Name         *string `json:"name,omitempty"`
+	Description  *string `json:"description,omitempty"`
+	Instructions *string `json:"instructions,omitempty"`
+	Shortform    *string `json:"shortform,omitempty"`
+	Required     *bool   `json:"required,omitempty"`
+}
+
 // List returns a list of incident roles.
 func (s *IncidentRolesService) List(ctx context.Context) ([]*IncidentRole, *http.Response, error) {
 	u := "v2/incident_roles"
@@ -61,3 +90,97 @@ func (s *IncidentRolesService) List(ctx context.Context) ([]*IncidentRole, *http
 
 	return result.IncidentRoles, resp, nil
 }
+
+// Get returns a single incident role.
+func (s *IncidentRolesService) Get(ctx context.Context, id string) (*IncidentRole, *http.Response, error) {
+	u := fmt.Sprintf("v2/incident_roles/%s", id)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		IncidentRole *IncidentRole `json:"incident_role"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.IncidentRole, resp, nil
+}
+
+// Create creates a new incident role.
+func (s *IncidentRolesService) Create(ctx context.Context, opts *CreateIncidentRoleOptions) (*IncidentRole, *http.Response, error) {
+	u := "v2/incident_roles"
+
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		IncidentRole *IncidentRole `json:"incident_role"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.IncidentRole, resp, nil
+}
+
+// Update updates an incident role.
+func (s *IncidentRolesService) Update(ctx context.Context, id string, opts *UpdateIncidentRoleOptions) (*IncidentRole, *http.Response, error) {
+	u := fmt.Sprintf("v2/incident_roles/%s", id)
+
+	req, err := s.client.NewRequest("PUT", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		IncidentRole *IncidentRole `json:"incident_role"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.IncidentRole, resp, nil
+}
+
+// Delete deletes an incident role.
+func (s *IncidentRolesService) Delete(ctx context.Context, id string) (*http.Response, error) {
+	u := fmt.Sprintf("v2/incident_roles/%s", id)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// UnfilledRoles returns the required roles among roles that have no assignee
+// in incident.
+func UnfilledRoles(incident *Incident, roles []*IncidentRole) []*IncidentRole {
+	filled := make(map[string]bool)
+	if incident != nil {
+		for _, a := range incident.IncidentRoleAssignments {
+			if a.Role != nil && a.Assignee != nil {
+				filled[a.Role.ID] = true
+			}
+		}
+	}
+
+	var unfilled []*IncidentRole
+	for _, r := range roles {
+		if r.Required && !filled[r.ID] {
+			unfilled = append(unfilled, r)
+		}
+	}
+
+	return unfilled
+}
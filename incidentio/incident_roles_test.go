@@ -0,0 +1,179 @@
+package incidentio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestIncidentRolesService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/incident_roles/role-lead", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Authorization", "Bearer test-key")
+
+		_, _ = fmt.Fprint(w, `{
+			"incident_role": {
+				"id": "role-lead",
+				"name": "Incident Lead",
+				"description": "The person currently coordinating the incident",
+				"instructions": "Take point on the incident; Make sure people are clear on responsibilities",
+				"shortform": "lead",
+				"role_type": "lead",
+				"required": true,
+				"created_at": "2021-08-17T13:28:57Z",
+				"updated_at": "2021-08-17T13:28:57Z"
+			}
+		}`)
+	})
+
+	ctx := context.Background()
+	role, _, err := client.IncidentRoles.Get(ctx, "role-lead")
+	if err != nil {
+		t.Errorf("IncidentRoles.Get returned error: %v", err)
+	}
+
+	expected := &IncidentRole{
+		ID:           "role-lead",
+		Name:         "Incident Lead",
+		Description:  "The person currently coordinating the incident",
+		Instructions: "Take point on the incident; Make sure people are clear on responsibilities",
+		Shortform:    "lead",
+		RoleType:     IncidentRoleTypeLead,
+		Required:     true,
+		CreatedAt:    Timestamp{parseTime("2021-08-17T13:28:57Z")},
+		UpdatedAt:    Timestamp{parseTime("2021-08-17T13:28:57Z")},
+	}
+
+	if !reflect.DeepEqual(role, expected) {
+		t.Errorf("IncidentRoles.Get returned %+v, want %+v", role, expected)
+	}
+}
+
+func TestIncidentRolesService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CreateIncidentRoleOptions{
+		Name:         "Communications Lead",
+		Description:  "Keeps stakeholders informed",
+		Instructions: "Post an update to the status page every 30 minutes",
+		Shortform:    "comms",
+		Required:     true,
+	}
+
+	mux.HandleFunc("/v2/incident_roles", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", "application/json")
+
+		var received CreateIncidentRoleOptions
+		err := json.NewDecoder(r.Body).Decode(&received)
+		if err != nil {
+			t.Errorf("error decoding request body: %v", err)
+		}
+
+		if !reflect.DeepEqual(received, *input) {
+			t.Errorf("Request body = %+v, want %+v", received, *input)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `{"incident_role": {"id": "role-comms", "name": "Communications Lead", "shortform": "comms", "role_type": "custom", "required": true}}`)
+	})
+
+	ctx := context.Background()
+	role, resp, err := client.IncidentRoles.Create(ctx, input)
+	if err != nil {
+		t.Errorf("IncidentRoles.Create returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Errorf("IncidentRoles.Create returned status %d, want %d", resp.StatusCode, http.StatusCreated)
+	}
+
+	if role.RoleType != IncidentRoleTypeCustom {
+		t.Errorf("IncidentRoles.Create returned RoleType %s, want %s", role.RoleType, IncidentRoleTypeCustom)
+	}
+}
+
+func TestIncidentRolesService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	required := false
+	input := &UpdateIncidentRoleOptions{Required: &required}
+
+	mux.HandleFunc("/v2/incident_roles/role-comms", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("error decoding request body: %v", err)
+		}
+
+		if want := map[string]interface{}{"required": false}; !reflect.DeepEqual(body, want) {
+			t.Errorf("Request body = %+v, want %+v", body, want)
+		}
+
+		_, _ = fmt.Fprint(w, `{"incident_role": {"id": "role-comms", "name": "Communications Lead", "required": false}}`)
+	})
+
+	ctx := context.Background()
+	role, _, err := client.IncidentRoles.Update(ctx, "role-comms", input)
+	if err != nil {
+		t.Errorf("IncidentRoles.Update returned error: %v", err)
+	}
+
+	if role.Required {
+		t.Error("IncidentRoles.Update returned Required true, want false")
+	}
+}
+
+func TestIncidentRolesService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/incident_roles/role-comms", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	resp, err := client.IncidentRoles.Delete(ctx, "role-comms")
+	if err != nil {
+		t.Errorf("IncidentRoles.Delete returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("IncidentRoles.Delete returned status %d, want %d", resp.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestUnfilledRoles(t *testing.T) {
+	lead := &IncidentRole{ID: "role-lead", Name: "Incident Lead", Required: true}
+	comms := &IncidentRole{ID: "role-comms", Name: "Communications Lead", Required: true}
+	scribe := &IncidentRole{ID: "role-scribe", Name: "Scribe"}
+	roles := []*IncidentRole{lead, comms, scribe}
+
+	incident := &Incident{
+		ID: "incident-1",
+		IncidentRoleAssignments: []IncidentRoleAssignment{
+			{Role: comms, Assignee: &User{ID: "user-1"}},
+			{Role: lead},
+		},
+	}
+
+	unfilled := UnfilledRoles(incident, roles)
+	if want := []*IncidentRole{lead}; !reflect.DeepEqual(unfilled, want) {
+		t.Errorf("UnfilledRoles returned %+v, want %+v", unfilled, want)
+	}
+
+	incident.IncidentRoleAssignments[1].Assignee = &User{ID: "user-2"}
+	if unfilled := UnfilledRoles(incident, roles); len(unfilled) != 0 {
+		t.Errorf("UnfilledRoles returned %+v, want none", unfilled)
+	}
+}
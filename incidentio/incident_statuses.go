@@ -0,0 +1,155 @@
+package incidentio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Incident status categories.
+const (
+	IncidentStatusCategoryTriage   = "triage"
+	IncidentStatusCategoryLive     = "live"
+	IncidentStatusCategoryLearning = "learning"
+	IncidentStatusCategoryClosed   = "closed"
+	IncidentStatusCategoryDeclined = "declined"
+	IncidentStatusCategoryMerged   = "merged"
+	IncidentStatusCategoryCanceled = "canceled"
+	IncidentStatusCategoryPaused   = "paused"
+)
+
+// IncidentStatus represents a configurable incident status. Category tells
+// statuses apart regardless of how they are named.
+type IncidentStatus struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Category    string    `json:"category"`
+	Rank        int       `json:"rank"`
+	CreatedAt   Timestamp `json:"created_at"`
+	UpdatedAt   Timestamp `json:"updated_at"`
+}
+
+// IncidentStatusesService handles communication with the incident status
+// related methods.
+type IncidentStatusesService struct {
+	client *Client
+}
+
+// CreateIncidentStatusOptions represents options for creating an incident
+// status.
+type CreateIncidentStatusOptions struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Category    string `json:"category"`
+}
+
+// UpdateIncidentStatusOptions represents options for updating an incident
+// status. The category of a status cannot be changed.
+type UpdateIncidentStatusOptions struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// List returns a list of incident statuses.
+func (s *IncidentStatusesService) List(ctx context.Context) ([]*IncidentStatus, *http.Response, error) {
+	u := "v1/incident_statuses"
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		IncidentStatuses []*IncidentStatus `json:"incident_statuses"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.IncidentStatuses, resp, nil
+}
+
+// Get returns a single incident status.
+func (s *IncidentStatusesService) Get(ctx context.Context, id string) (*IncidentStatus, *http.Response, error) {
+	u := fmt.Sprintf("v1/incident_statuses/%s", id)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		IncidentStatus *IncidentStatus `json:"incident_status"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.IncidentStatus, resp, nil
+}
+
+// Create creates a new incident status.
+func (s *IncidentStatusesService) Create(ctx context.Context, opts *CreateIncidentStatusOptions) (*IncidentStatus, *http.Response, error) {
+	u := "v1/incident_statuses"
+
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		IncidentStatus *IncidentStatus `json:"incident_status"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.IncidentStatus, resp, nil
+}
+
+// Update updates an incident status.
+func (s *IncidentStatusesService) Update(ctx context.Context, id string, opts *UpdateIncidentStatusOptions) (*IncidentStatus, *http.Response, error) {
+	u := fmt.Sprintf("v1/incident_statuses/%s", id)
+
+	req, err := s.client.NewRequest("PUT", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		IncidentStatus *IncidentStatus `json:"incident_status"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.IncidentStatus, resp, nil
+}
+
+// Delete deletes an incident status.
+func (s *IncidentStatusesService) Delete(ctx context.Context, id string) (*http.Response, error) {
+	u := fmt.Sprintf("v1/incident_statuses/%s", id)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// StatusCategory returns the category of the incident's status, such as
+// IncidentStatusCategoryClosed or IncidentStatusCategoryDeclined. It falls
+// back to Status when the incident carries no IncidentStatus.
+func (i *Incident) StatusCategory() string {
+	if i.IncidentStatus != nil && i.IncidentStatus.Category != "" {
+		return i.IncidentStatus.Category
+	}
+
+	return i.Status
+}
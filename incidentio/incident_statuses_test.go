@@ -0,0 +1,213 @@
+package incidentio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestIncidentStatusesService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/incident_statuses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Authorization", "Bearer test-key")
+
+		_, _ = fmt.Fprint(w, `{
+			"incident_statuses": [
+				{
+					"id": "status-investigating",
+					"name": "Investigating",
+					"description": "We're looking into it.",
+					"category": "live",
+					"rank": 1,
+					"created_at": "2021-08-17T13:28:57Z",
+					"updated_at": "2021-08-17T13:28:57Z"
+				},
+				{
+					"id": "status-done",
+					"name": "All sorted",
+					"description": "Nothing left to do.",
+					"category": "closed",
+					"rank": 4,
+					"created_at": "2021-08-17T13:28:57Z",
+					"updated_at": "2021-08-17T13:28:57Z"
+				}
+			]
+		}`)
+	})
+
+	ctx := context.Background()
+	statuses, _, err := client.IncidentStatuses.List(ctx)
+	if err != nil {
+		t.Errorf("IncidentStatuses.List returned error: %v", err)
+	}
+
+	expected := []*IncidentStatus{
+		{
+			ID:          "status-investigating",
+			Name:        "Investigating",
+			Description: "We're looking into it.",
+			Category:    IncidentStatusCategoryLive,
+			Rank:        1,
+			CreatedAt:   Timestamp{parseTime("2021-08-17T13:28:57Z")},
+			UpdatedAt:   Timestamp{parseTime("2021-08-17T13:28:57Z")},
+		},
+		{
+			ID:          "status-done",
+			Name:        "All sorted",
+			Description: "Nothing left to do.",
+			Category:    IncidentStatusCategoryClosed,
+			Rank:        4,
+			CreatedAt:   Timestamp{parseTime("2021-08-17T13:28:57Z")},
+			UpdatedAt:   Timestamp{parseTime("2021-08-17T13:28:57Z")},
+		},
+	}
+
+	if !reflect.DeepEqual(statuses, expected) {
+		t.Errorf("IncidentStatuses.List returned %+v, want %+v", statuses, expected)
+	}
+}
+
+func TestIncidentStatusesService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/incident_statuses/status-done", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{"incident_status": {"id": "status-done", "name": "All sorted", "category": "closed", "rank": 4}}`)
+	})
+
+	ctx := context.Background()
+	status, _, err := client.IncidentStatuses.Get(ctx, "status-done")
+	if err != nil {
+		t.Errorf("IncidentStatuses.Get returned error: %v", err)
+	}
+
+	if status.Category != IncidentStatusCategoryClosed {
+		t.Errorf("IncidentStatuses.Get returned Category %s, want %s", status.Category, IncidentStatusCategoryClosed)
+	}
+}
+
+func TestIncidentStatusesService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CreateIncidentStatusOptions{
+		Name:        "Monitoring",
+		Description: "A fix is out and we're watching.",
+		Category:    IncidentStatusCategoryLive,
+	}
+
+	mux.HandleFunc("/v1/incident_statuses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", "application/json")
+
+		var received CreateIncidentStatusOptions
+		err := json.NewDecoder(r.Body).Decode(&received)
+		if err != nil {
+			t.Errorf("error decoding request body: %v", err)
+		}
+
+		if !reflect.DeepEqual(received, *input) {
+			t.Errorf("Request body = %+v, want %+v", received, *input)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `{"incident_status": {"id": "status-monitoring", "name": "Monitoring", "category": "live", "rank": 3}}`)
+	})
+
+	ctx := context.Background()
+	status, resp, err := client.IncidentStatuses.Create(ctx, input)
+	if err != nil {
+		t.Errorf("IncidentStatuses.Create returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Errorf("IncidentStatuses.Create returned status %d, want %d", resp.StatusCode, http.StatusCreated)
+	}
+
+	if status.ID != "status-monitoring" {
+		t.Errorf("IncidentStatuses.Create returned ID %s, want status-monitoring", status.ID)
+	}
+}
+
+func TestIncidentStatusesService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	name := "Watching"
+	input := &UpdateIncidentStatusOptions{Name: &name}
+
+	mux.HandleFunc("/v1/incident_statuses/status-monitoring", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+
+		var received UpdateIncidentStatusOptions
+		err := json.NewDecoder(r.Body).Decode(&received)
+		if err != nil {
+			t.Errorf("error decoding request body: %v", err)
+		}
+
+		if !reflect.DeepEqual(received, *input) {
+			t.Errorf("Request body = %+v, want %+v", received, *input)
+		}
+
+		_, _ = fmt.Fprint(w, `{"incident_status": {"id": "status-monitoring", "name": "Watching", "category": "live"}}`)
+	})
+
+	ctx := context.Background()
+	status, _, err := client.IncidentStatuses.Update(ctx, "status-monitoring", input)
+	if err != nil {
+		t.Errorf("IncidentStatuses.Update returned error: %v", err)
+	}
+
+	if status.Name != "Watching" {
+		t.Errorf("IncidentStatuses.Update returned Name %s, want Watching", status.Name)
+	}
+}
+
+func TestIncidentStatusesService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/incident_statuses/status-monitoring", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	resp, err := client.IncidentStatuses.Delete(ctx, "status-monitoring")
+	if err != nil {
+		t.Errorf("IncidentStatuses.Delete returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("IncidentStatuses.Delete returned status %d, want %d", resp.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestIncident_StatusCategory(t *testing.T) {
+	data := `{
+		"id": "incident-1",
+		"status": "all_sorted",
+		"incident_status": {"id": "status-done", "name": "All sorted", "category": "closed"}
+	}`
+
+	incident := &Incident{}
+	if err := json.Unmarshal([]byte(data), incident); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+
+	if got := incident.StatusCategory(); got != IncidentStatusCategoryClosed {
+		t.Errorf("StatusCategory returned %q, want %q", got, IncidentStatusCategoryClosed)
+	}
+
+	legacy := &Incident{Status: "declined"}
+	if got := legacy.StatusCategory(); got != IncidentStatusCategoryDeclined {
+		t.Errorf("StatusCategory returned %q, want %q", got, IncidentStatusCategoryDeclined)
+	}
+}
@@ -0,0 +1,89 @@
+package incidentio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// IncidentSubscriptionsService handles communication with the incident
+// subscription related methods.
+type IncidentSubscriptionsService struct {
+	client *Client
+}
+
+// IncidentSubscription subscribes a user to the updates of an incident.
+type IncidentSubscription struct {
+	ID         string    `json:"id"`
+	IncidentID string    `json:"incident_id"`
+	User       *User     `json:"user"`
+	CreatedAt  Timestamp `json:"created_at"`
+}
+
+// ListIncidentSubscriptionsOptions represents options for listing incident
+// subscriptions.
+type ListIncidentSubscriptionsOptions struct {
+	IncidentID string `url:"incident_id,omitempty"`
+}
+
+// CreateIncidentSubscriptionOptions represents options for subscribing a
+// user to an incident.
+type CreateIncidentSubscriptionOptions struct {
+	IncidentID string `json:"incident_id"`
+	UserID     string `json:"user_id"`
+}
+
+// List returns a list of incident subscriptions.
+func (s *IncidentSubscriptionsService) List(ctx context.Context, opts *ListIncidentSubscriptionsOptions) ([]*IncidentSubscription, *http.Response, error) {
+	u, err := addOptions("v1/incident_subscriptions", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		IncidentSubscriptions []*IncidentSubscription `json:"incident_subscriptions"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.IncidentSubscriptions, resp, nil
+}
+
+// Create subscribes a user to an incident.
+func (s *IncidentSubscriptionsService) Create(ctx context.Context, opts *CreateIncidentSubscriptionOptions) (*IncidentSubscription, *http.Response, error) {
+	u := "v1/incident_subscriptions"
+
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		IncidentSubscription *IncidentSubscription `json:"incident_subscription"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.IncidentSubscription, resp, nil
+}
+
+// Delete unsubscribes a user from an incident.
+func (s *IncidentSubscriptionsService) Delete(ctx context.Context, id string) (*http.Response, error) {
+	u := fmt.Sprintf("v1/incident_subscriptions/%s", id)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
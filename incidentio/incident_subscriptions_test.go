@@ -0,0 +1,119 @@
+package incidentio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestIncidentSubscriptionsService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/incident_subscriptions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Authorization", "Bearer test-key")
+		if got := r.URL.Query().Get("incident_id"); got != "incident-1" {
+			t.Errorf("incident_id = %q, want incident-1", got)
+		}
+
+		_, _ = fmt.Fprint(w, `{
+			"incident_subscriptions": [
+				{
+					"id": "subscription-1",
+					"incident_id": "incident-1",
+					"user": {"id": "user-1", "name": "Alice"},
+					"created_at": "2021-08-17T13:28:57Z"
+				}
+			]
+		}`)
+	})
+
+	ctx := context.Background()
+	subscriptions, _, err := client.IncidentSubscriptions.List(ctx, &ListIncidentSubscriptionsOptions{IncidentID: "incident-1"})
+	if err != nil {
+		t.Errorf("IncidentSubscriptions.List returned error: %v", err)
+	}
+
+	expected := []*IncidentSubscription{
+		{
+			ID:         "subscription-1",
+			IncidentID: "incident-1",
+			User:       &User{ID: "user-1", Name: "Alice"},
+			CreatedAt:  Timestamp{parseTime("2021-08-17T13:28:57Z")},
+		},
+	}
+
+	if !reflect.DeepEqual(subscriptions, expected) {
+		t.Errorf("IncidentSubscriptions.List returned %+v, expected %+v", subscriptions, expected)
+	}
+}
+
+func TestIncidentSubscriptionsService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CreateIncidentSubscriptionOptions{IncidentID: "incident-1", UserID: "user-1"}
+
+	mux.HandleFunc("/v1/incident_subscriptions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		var got CreateIncidentSubscriptionOptions
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("error decoding request body: %v", err)
+		}
+		if !reflect.DeepEqual(&got, input) {
+			t.Errorf("Request body = %+v, expected %+v", got, input)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `{
+			"incident_subscription": {
+				"id": "subscription-1",
+				"incident_id": "incident-1",
+				"user": {"id": "user-1", "name": "Alice"},
+				"created_at": "2021-08-17T13:28:57Z"
+			}
+		}`)
+	})
+
+	ctx := context.Background()
+	subscription, _, err := client.IncidentSubscriptions.Create(ctx, input)
+	if err != nil {
+		t.Errorf("IncidentSubscriptions.Create returned error: %v", err)
+	}
+
+	expected := &IncidentSubscription{
+		ID:         "subscription-1",
+		IncidentID: "incident-1",
+		User:       &User{ID: "user-1", Name: "Alice"},
+		CreatedAt:  Timestamp{parseTime("2021-08-17T13:28:57Z")},
+	}
+
+	if !reflect.DeepEqual(subscription, expected) {
+		t.Errorf("IncidentSubscriptions.Create returned %+v, expected %+v", subscription, expected)
+	}
+}
+
+func TestIncidentSubscriptionsService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/incident_subscriptions/subscription-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	resp, err := client.IncidentSubscriptions.Delete(ctx, "subscription-1")
+	if err != nil {
+		t.Errorf("IncidentSubscriptions.Delete returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("IncidentSubscriptions.Delete returned status %d, want %d", resp.StatusCode, http.StatusNoContent)
+	}
+}
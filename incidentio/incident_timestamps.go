@@ -0,0 +1,138 @@
+package incidentio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// IncidentTimestampsService handles communication with the incident
+// timestamp related methods.
+type IncidentTimestampsService struct {
+	client *Client
+}
+
+// IncidentTimestamp represents a timestamp tracked on incidents, such as
+// "Reported at", "Impact started" or "Fixed at", or a custom timestamp
+// defined by the organization.
+type IncidentTimestamp struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Rank int    `json:"rank"`
+}
+
+// IncidentTimestampValue represents the value of a timestamp on an incident.
+// Value is nil when the timestamp has not been set.
+type IncidentTimestampValue struct {
+	IncidentTimestamp *IncidentTimestamp `json:"incident_timestamp"`
+	Value             *TimestampValue    `json:"value,omitempty"`
+}
+
+// TimestampValue holds the time an incident timestamp was set to.
+type TimestampValue struct {
+	Value *Timestamp `json:"value,omitempty"`
+}
+
+// DurationMetric represents a duration incident.io computes between two
+// incident timestamps, such as the time to fix.
+type DurationMetric struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// IncidentDurationMetric represents the value of a duration metric on an
+// incident. ValueSeconds is nil when the timestamps it depends on are unset.
+type IncidentDurationMetric struct {
+	DurationMetric *DurationMetric `json:"duration_metric"`
+	ValueSeconds   *int64          `json:"value_seconds,omitempty"`
+}
+
+// IncidentTimestampValuePayload sets the value of a timestamp on an incident.
+// A nil Value clears the timestamp.
+type IncidentTimestampValuePayload struct {
+	IncidentTimestampID string     `json:"incident_timestamp_id"`
+	Value               *Timestamp `json:"value"`
+}
+
+// Timestamp returns the value of the incident timestamp with the given name.
+// It reports false when the incident has no value for it.
+func (i *Incident) Timestamp(name string) (time.Time, bool) {
+	for _, v := range i.IncidentTimestampValues {
+		if v.IncidentTimestamp == nil || v.IncidentTimestamp.Name != name {
+			continue
+		}
+		if v.Value == nil || v.Value.Value == nil {
+			return time.Time{}, false
+		}
+
+		return v.Value.Value.Time, true
+	}
+
+	return time.Time{}, false
+}
+
+// Duration returns the value of the duration metric with the given name, as
+// computed by incident.io. It reports false when the metric has no value.
+func (i *Incident) Duration(name string) (time.Duration, bool) {
+	for _, m := range i.DurationMetrics {
+		if m.DurationMetric == nil || m.DurationMetric.Name != name {
+			continue
+		}
+		if m.ValueSeconds == nil {
+			return 0, false
+		}
+
+		return time.Duration(*m.ValueSeconds) * time.Second, true
+	}
+
+	return 0, false
+}
+
+// List returns the incident timestamps defined for the organization.
+func (s *IncidentTimestampsService) List(ctx context.Context) ([]*IncidentTimestamp, *http.Response, error) {
+	u := "v2/incident_timestamps"
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		IncidentTimestamps []*IncidentTimestamp `json:"incident_timestamps"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.IncidentTimestamps, resp, nil
+}
+
+// Get returns a single incident timestamp.
+func (s *IncidentTimestampsService) Get(ctx context.Context, id string) (*IncidentTimestamp, *http.Response, error) {
+	u := fmt.Sprintf("v2/incident_timestamps/%s", id)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		IncidentTimestamp *IncidentTimestamp `json:"incident_timestamp"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.IncidentTimestamp, resp, nil
+}
+
+// SetValues sets timestamp values on an incident. Timestamps that are not
+// listed keep their values.
+func (s *IncidentTimestampsService) SetValues(ctx context.Context, incidentID string, values ...IncidentTimestampValuePayload) (*Incident, *http.Response, error) {
+	return s.client.Incidents.Update(ctx, incidentID, &UpdateIncidentOptions{
+		IncidentTimestampValues: values,
+	})
+}
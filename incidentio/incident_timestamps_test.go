@@ -0,0 +1,147 @@
+package incidentio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestIncidentTimestampsService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/incident_timestamps", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Authorization", "Bearer test-key")
+
+		_, _ = fmt.Fprint(w, `{
+			"incident_timestamps": [
+				{"id": "ts-reported", "name": "Reported at", "rank": 1},
+				{"id": "ts-impact", "name": "Impact started", "rank": 2}
+			]
+		}`)
+	})
+
+	ctx := context.Background()
+	timestamps, _, err := client.IncidentTimestamps.List(ctx)
+	if err != nil {
+		t.Errorf("IncidentTimestamps.List returned error: %v", err)
+	}
+
+	expected := []*IncidentTimestamp{
+		{ID: "ts-reported", Name: "Reported at", Rank: 1},
+		{ID: "ts-impact", Name: "Impact started", Rank: 2},
+	}
+
+	if !reflect.DeepEqual(timestamps, expected) {
+		t.Errorf("IncidentTimestamps.List returned %+v, expected %+v", timestamps, expected)
+	}
+}
+
+func TestIncidentTimestampsService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/incident_timestamps/ts-fixed", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{"incident_timestamp": {"id": "ts-fixed", "name": "Fixed at", "rank": 3}}`)
+	})
+
+	ctx := context.Background()
+	timestamp, _, err := client.IncidentTimestamps.Get(ctx, "ts-fixed")
+	if err != nil {
+		t.Errorf("IncidentTimestamps.Get returned error: %v", err)
+	}
+
+	expected := &IncidentTimestamp{ID: "ts-fixed", Name: "Fixed at", Rank: 3}
+	if !reflect.DeepEqual(timestamp, expected) {
+		t.Errorf("IncidentTimestamps.Get returned %+v, expected %+v", timestamp, expected)
+	}
+}
+
+func TestIncidentTimestampsService_SetValues(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	values := []IncidentTimestampValuePayload{
+		{IncidentTimestampID: "ts-impact", Value: &Timestamp{parseTime("2021-08-17T13:00:00Z")}},
+		{IncidentTimestampID: "ts-fixed"},
+	}
+
+	mux.HandleFunc("/v2/incidents/incident-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("error reading request body: %v", err)
+		}
+
+		// The cleared timestamp must be sent as an explicit null.
+		want := `{"incident_timestamp_values":[{"incident_timestamp_id":"ts-impact","value":"2021-08-17T13:00:00Z"},{"incident_timestamp_id":"ts-fixed","value":null}]}`
+		if got := strings.TrimSpace(string(body)); got != want {
+			t.Errorf("Request body = %s, expected %s", got, want)
+		}
+
+		_, _ = fmt.Fprint(w, `{"incident": {"id": "incident-1"}}`)
+	})
+
+	ctx := context.Background()
+	incident, _, err := client.IncidentTimestamps.SetValues(ctx, "incident-1", values...)
+	if err != nil {
+		t.Errorf("IncidentTimestamps.SetValues returned error: %v", err)
+	}
+
+	if incident.ID != "incident-1" {
+		t.Errorf("IncidentTimestamps.SetValues returned incident %q, expected incident-1", incident.ID)
+	}
+}
+
+func TestIncident_TimestampsAndDurations(t *testing.T) {
+	var incident Incident
+	err := json.Unmarshal([]byte(`{
+		"id": "incident-1",
+		"incident_timestamp_values": [
+			{
+				"incident_timestamp": {"id": "ts-impact", "name": "Impact started", "rank": 2},
+				"value": {"value": "2021-08-17T13:00:00Z"}
+			},
+			{
+				"incident_timestamp": {"id": "ts-fixed", "name": "Fixed at", "rank": 3},
+				"value": {"value": "2021-08-17T14:30:00Z"}
+			},
+			{
+				"incident_timestamp": {"id": "ts-resolved", "name": "Resolved at", "rank": 4}
+			}
+		],
+		"duration_metrics": [
+			{"duration_metric": {"id": "dm-fix", "name": "Time to fix"}, "value_seconds": 5400},
+			{"duration_metric": {"id": "dm-impact", "name": "Impact duration"}}
+		]
+	}`), &incident)
+	if err != nil {
+		t.Fatalf("error decoding incident: %v", err)
+	}
+
+	if got, ok := incident.Timestamp("Impact started"); !ok || !got.Equal(parseTime("2021-08-17T13:00:00Z")) {
+		t.Errorf("Timestamp(Impact started) = %v, %v, expected 2021-08-17T13:00:00Z", got, ok)
+	}
+	if _, ok := incident.Timestamp("Resolved at"); ok {
+		t.Error("Timestamp(Resolved at) reported a value for an unset timestamp")
+	}
+	if _, ok := incident.Timestamp("Accepted at"); ok {
+		t.Error("Timestamp(Accepted at) reported a value for a missing timestamp")
+	}
+
+	if got, ok := incident.Duration("Time to fix"); !ok || got != 90*time.Minute {
+		t.Errorf("Duration(Time to fix) = %v, %v, expected 1h30m0s", got, ok)
+	}
+	if _, ok := incident.Duration("Impact duration"); ok {
+		t.Error("Duration(Impact duration) reported a value for an unset metric")
+	}
+}
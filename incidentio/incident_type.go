@@ -2,9 +2,16 @@ package incidentio
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 )
 
+// Incident type triage settings.
+const (
+	CreateInTriageAlways   = "always"
+	CreateInTriageOptional = "optional"
+)
+
 // IncidentTypesService handles communication with the incident type related methods.
 type IncidentTypesService struct {
 	client *Client
@@ -12,11 +19,40 @@ type IncidentTypesService struct {
 
 // IncidentType represents an incident type in Incident.io.
 type IncidentType struct {
-	ID          string    `json:"id"`
-	Name        string    `json:"name"`
-	Description string    `json:"description"`
-	CreatedAt   Timestamp `json:"created_at"`
-	UpdatedAt   Timestamp `json:"updated_at"`
+	ID                   string    `json:"id"`
+	Name                 string    `json:"name"`
+	Description          string    `json:"description"`
+	IsDefault            bool      `json:"is_default"`
+	PrivateIncidentsOnly bool      `json:"private_incidents_only"`
+	CreateInTriage       string    `json:"create_in_triage,omitempty"`
+	CreatedAt            Timestamp `json:"created_at"`
+	UpdatedAt            Timestamp `json:"updated_at"`
+}
+
+// IncidentTypeRequirements represents the custom fields and roles that must
+// be filled in for incidents of a type.
+type IncidentTypeRequirements struct {
+	IncidentType  *IncidentType
+	CustomFields  []*CustomField
+	IncidentRoles []*IncidentRole
+}
+
+// CreateIncidentTypeOptions represents options for creating an incident type.
+type CreateIncidentTypeOptions struct {
+	Name                 string `json:"name"`
+	Description          string `json:"description"`
+	IsDefault            bool   `json:"is_default,omitempty"`
+	PrivateIncidentsOnly bool   `json:"private_incidents_only,omitempty"`
+	CreateInTriage       string `json:"create_in_triage,omitempty"`
+}
+
+// UpdateIncidentTypeOptions represents options for updating an incident type.
+type UpdateIncidentTypeOptions struct {
+	Name                 *string `json:"name,omitempty"`
+	Description          *string `json:"description,omitempty"`
+	IsDefault            *bool   `json:"is_default,omitempty"`
+	PrivateIncidentsOnly *bool   `json:"private_incidents_only,omitempty"`
+	CreateInTriage       *string `json:"create_in_triage,omitempty"`
 }
 
 // List returns a list of incident types.
@@ -38,3 +74,111 @@ func (s *IncidentTypesService) List(ctx context.Context) ([]*IncidentType, *http
 
 	return result.IncidentTypes, resp, nil
 }
+
+// Get returns a single incident type.
+func (s *IncidentTypesService) Get(ctx context.Context, id string) (*IncidentType, *http.Response, error) {
+	u := fmt.Sprintf("v1/incident_types/%s", id)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		IncidentType *IncidentType `json:"incident_type"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.IncidentType, resp, nil
+}
+
+// Create creates a new incident type.
+func (s *IncidentTypesService) Create(ctx context.Context, opts *CreateIncidentTypeOptions) (*IncidentType, *http.Response, error) {
+	u := "v1/incident_types"
+
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		IncidentType *IncidentType `json:"incident_type"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.IncidentType, resp, nil
+}
+
+// Update updates an incident type.
+func (s *IncidentTypesService) Update(ctx context.Context, id string, opts *UpdateIncidentTypeOptions) (*IncidentType, *http.Response, error) {
+	u := fmt.Sprintf("v1/incident_types/%s", id)
+
+	req, err := s.client.NewRequest("PUT", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		IncidentType *IncidentType `json:"incident_type"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.IncidentType, resp, nil
+}
+
+// Delete deletes an incident type.
+func (s *IncidentTypesService) Delete(ctx context.Context, id string) (*http.Response, error) {
+	u := fmt.Sprintf("v1/incident_types/%s", id)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// Requirements returns the custom fields and roles required for incidents of
+// the type with the given ID. A required custom field applies to a type when
+// it lists the type in IncidentTypeIDs or is not restricted to any type.
+// Required roles apply to every type. The returned response is that of the
+// last request made.
+func (s *IncidentTypesService) Requirements(ctx context.Context, id string) (*IncidentTypeRequirements, *http.Response, error) {
+	incidentType, resp, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	fields, resp, err := s.client.CustomFields.List(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	roles, resp, err := s.client.IncidentRoles.List(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	requirements := &IncidentTypeRequirements{IncidentType: incidentType}
+	for _, f := range fields {
+		if f.Required && f.AppliesTo(id) {
+			requirements.CustomFields = append(requirements.CustomFields, f)
+		}
+	}
+	for _, r := range roles {
+		if r.Required {
+			requirements.IncidentRoles = append(requirements.IncidentRoles, r)
+		}
+	}
+
+	return requirements, resp, nil
+}
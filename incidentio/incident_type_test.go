@@ -0,0 +1,200 @@
+package incidentio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestIncidentTypesService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/incident_types/type-security", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Authorization", "Bearer test-key")
+
+		_, _ = fmt.Fprint(w, `{
+			"incident_type": {
+				"id": "type-security",
+				"name": "Security",
+				"description": "Security incidents are always private.",
+				"is_default": false,
+				"private_incidents_only": true,
+				"create_in_triage": "always",
+				"created_at": "2021-08-17T13:28:57Z",
+				"updated_at": "2021-08-17T13:28:57Z"
+			}
+		}`)
+	})
+
+	ctx := context.Background()
+	incidentType, _, err := client.IncidentTypes.Get(ctx, "type-security")
+	if err != nil {
+		t.Errorf("IncidentTypes.Get returned error: %v", err)
+	}
+
+	expected := &IncidentType{
+		ID:                   "type-security",
+		Name:                 "Security",
+		Description:          "Security incidents are always private.",
+		PrivateIncidentsOnly: true,
+		CreateInTriage:       CreateInTriageAlways,
+		CreatedAt:            Timestamp{parseTime("2021-08-17T13:28:57Z")},
+		UpdatedAt:            Timestamp{parseTime("2021-08-17T13:28:57Z")},
+	}
+
+	if !reflect.DeepEqual(incidentType, expected) {
+		t.Errorf("IncidentTypes.Get returned %+v, want %+v", incidentType, expected)
+	}
+}
+
+func TestIncidentTypesService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CreateIncidentTypeOptions{
+		Name:                 "Security",
+		Description:          "Security incidents are always private.",
+		PrivateIncidentsOnly: true,
+		CreateInTriage:       CreateInTriageAlways,
+	}
+
+	mux.HandleFunc("/v1/incident_types", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", "application/json")
+
+		var received CreateIncidentTypeOptions
+		err := json.NewDecoder(r.Body).Decode(&received)
+		if err != nil {
+			t.Errorf("error decoding request body: %v", err)
+		}
+
+		if !reflect.DeepEqual(received, *input) {
+			t.Errorf("Request body = %+v, want %+v", received, *input)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `{"incident_type": {"id": "type-security", "name": "Security", "private_incidents_only": true}}`)
+	})
+
+	ctx := context.Background()
+	incidentType, resp, err := client.IncidentTypes.Create(ctx, input)
+	if err != nil {
+		t.Errorf("IncidentTypes.Create returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Errorf("IncidentTypes.Create returned status %d, want %d", resp.StatusCode, http.StatusCreated)
+	}
+
+	if incidentType.ID != "type-security" {
+		t.Errorf("IncidentTypes.Create returned ID %s, want type-security", incidentType.ID)
+	}
+}
+
+func TestIncidentTypesService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	isDefault := true
+	input := &UpdateIncidentTypeOptions{IsDefault: &isDefault}
+
+	mux.HandleFunc("/v1/incident_types/type-product", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+
+		var received UpdateIncidentTypeOptions
+		err := json.NewDecoder(r.Body).Decode(&received)
+		if err != nil {
+			t.Errorf("error decoding request body: %v", err)
+		}
+
+		if !reflect.DeepEqual(received, *input) {
+			t.Errorf("Request body = %+v, want %+v", received, *input)
+		}
+
+		_, _ = fmt.Fprint(w, `{"incident_type": {"id": "type-product", "name": "Product", "is_default": true}}`)
+	})
+
+	ctx := context.Background()
+	incidentType, _, err := client.IncidentTypes.Update(ctx, "type-product", input)
+	if err != nil {
+		t.Errorf("IncidentTypes.Update returned error: %v", err)
+	}
+
+	if !incidentType.IsDefault {
+		t.Error("IncidentTypes.Update returned IsDefault false, want true")
+	}
+}
+
+func TestIncidentTypesService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/incident_types/type-product", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	resp, err := client.IncidentTypes.Delete(ctx, "type-product")
+	if err != nil {
+		t.Errorf("IncidentTypes.Delete returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("IncidentTypes.Delete returned status %d, want %d", resp.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestIncidentTypesService_Requirements(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/incident_types/type-security", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"incident_type": {"id": "type-security", "name": "Security"}}`)
+	})
+	mux.HandleFunc("/v2/custom_fields", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{
+			"custom_fields": [
+				{"id": "field-team", "name": "Affected team", "required": true},
+				{"id": "field-cve", "name": "CVE", "required": true, "incident_type_ids": ["type-security"]},
+				{"id": "field-customer", "name": "Customer", "required": true, "incident_type_ids": ["type-product"]},
+				{"id": "field-notes", "name": "Notes", "required": false}
+			]
+		}`)
+	})
+	mux.HandleFunc("/v2/incident_roles", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{
+			"incident_roles": [
+				{"id": "role-lead", "name": "Incident Lead", "required": true},
+				{"id": "role-comms", "name": "Communications", "required": false}
+			]
+		}`)
+	})
+
+	ctx := context.Background()
+	requirements, _, err := client.IncidentTypes.Requirements(ctx, "type-security")
+	if err != nil {
+		t.Fatalf("IncidentTypes.Requirements returned error: %v", err)
+	}
+
+	if requirements.IncidentType.Name != "Security" {
+		t.Errorf("IncidentTypes.Requirements returned type %+v, want Security", requirements.IncidentType)
+	}
+
+	var fieldIDs []string
+	for _, f := range requirements.CustomFields {
+		fieldIDs = append(fieldIDs, f.ID)
+	}
+	if want := []string{"field-team", "field-cve"}; !reflect.DeepEqual(fieldIDs, want) {
+		t.Errorf("IncidentTypes.Requirements returned custom fields %v, want %v", fieldIDs, want)
+	}
+
+	if len(requirements.IncidentRoles) != 1 || requirements.IncidentRoles[0].ID != "role-lead" {
+		t.Errorf("IncidentTypes.Requirements returned roles %+v, want role-lead", requirements.IncidentRoles)
+	}
+}
@@ -0,0 +1,97 @@
+package incidentio
+
+import (
+	"context"
+	"net/http"
+	"sort"
+)
+
+// IncidentUpdatesService handles communication with the incident update
+// related methods.
+type IncidentUpdatesService struct {
+	client *Client
+}
+
+// IncidentUpdate represents an update posted to an incident, recording a
+// message and any change of status or severity.
+type IncidentUpdate struct {
+	ID                string          `json:"id"`
+	IncidentID        string          `json:"incident_id"`
+	Message           string          `json:"message,omitempty"`
+	NewIncidentStatus *IncidentStatus `json:"new_incident_status,omitempty"`
+	NewSeverity       *Severity       `json:"new_severity,omitempty"`
+	Updater           *Actor          `json:"updater,omitempty"`
+	CreatedAt         Timestamp       `json:"created_at"`
+}
+
+// Actor represents who made a change: a user, or an API key for changes made
+// through the API.
+type Actor struct {
+	User   *User   `json:"user,omitempty"`
+	APIKey *APIKey `json:"api_key,omitempty"`
+}
+
+// APIKey represents an API key acting on the organization's behalf.
+type APIKey struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// ListIncidentUpdatesOptions represents options for listing incident updates.
+type ListIncidentUpdatesOptions struct {
+	IncidentID string `url:"incident_id,omitempty"`
+	ListOptions
+}
+
+// List returns a single page of incident updates.
+func (s *IncidentUpdatesService) List(ctx context.Context, opts *ListIncidentUpdatesOptions) ([]*IncidentUpdate, *http.Response, error) {
+	updates, _, resp, err := s.list(ctx, opts)
+	return updates, resp, err
+}
+
+// ListAll returns all incident updates matching opts, following pagination
+// until every page has been fetched. The updates are ordered oldest first,
+// so they read as a timeline.
+func (s *IncidentUpdatesService) ListAll(ctx context.Context, opts *ListIncidentUpdatesOptions) ([]*IncidentUpdate, *http.Response, error) {
+	pageOpts := ListIncidentUpdatesOptions{}
+	if opts != nil {
+		pageOpts = *opts
+	}
+
+	updates, resp, err := listAll(func(after string) ([]*IncidentUpdate, *PaginationMeta, *http.Response, error) {
+		pageOpts.After = after
+		return s.list(ctx, &pageOpts)
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	sort.SliceStable(updates, func(i, j int) bool {
+		return updates[i].CreatedAt.Before(updates[j].CreatedAt.Time)
+	})
+
+	return updates, resp, nil
+}
+
+func (s *IncidentUpdatesService) list(ctx context.Context, opts *ListIncidentUpdatesOptions) ([]*IncidentUpdate, *PaginationMeta, *http.Response, error) {
+	u, err := addOptions("v2/incident_updates", opts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var result struct {
+		IncidentUpdates []*IncidentUpdate `json:"incident_updates"`
+		PaginationMeta  *PaginationMeta   `json:"pagination_meta,omitempty"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, nil, resp, err
+	}
+
+	return result.IncidentUpdates, result.PaginationMeta, resp, nil
+}
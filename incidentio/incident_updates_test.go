@@ -0,0 +1,125 @@
+package incidentio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestIncidentUpdatesService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/incident_updates", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Authorization", "Bearer test-key")
+
+		if got := r.URL.Query().Get("incident_id"); got != "incident-1" {
+			t.Errorf("incident_id = %q, want %q", got, "incident-1")
+		}
+
+		_, _ = fmt.Fprint(w, `{
+			"incident_updates": [
+				{
+					"id": "update-1",
+					"incident_id": "incident-1",
+					"message": "We've found the cause and are rolling back.",
+					"new_incident_status": {"id": "status-fixing", "name": "Fixing", "category": "live", "rank": 2},
+					"new_severity": {"id": "sev-major", "name": "Major", "rank": 2},
+					"updater": {"user": {"id": "user-1", "name": "Lisa Karlin Curtis", "email": "lisa@example.com", "role": "owner"}},
+					"created_at": "2021-08-17T14:28:57Z"
+				},
+				{
+					"id": "update-2",
+					"incident_id": "incident-1",
+					"updater": {"api_key": {"id": "key-1", "name": "Status bot"}},
+					"created_at": "2021-08-17T15:28:57Z"
+				}
+			],
+			"pagination_meta": {"page_size": 25}
+		}`)
+	})
+
+	ctx := context.Background()
+	updates, _, err := client.IncidentUpdates.List(ctx, &ListIncidentUpdatesOptions{IncidentID: "incident-1"})
+	if err != nil {
+		t.Errorf("IncidentUpdates.List returned error: %v", err)
+	}
+
+	expected := []*IncidentUpdate{
+		{
+			ID:                "update-1",
+			IncidentID:        "incident-1",
+			Message:           "We've found the cause and are rolling back.",
+			NewIncidentStatus: &IncidentStatus{ID: "status-fixing", Name: "Fixing", Category: IncidentStatusCategoryLive, Rank: 2},
+			NewSeverity:       &Severity{ID: "sev-major", Name: "Major", Rank: 2},
+			Updater:           &Actor{User: &User{ID: "user-1", Name: "Lisa Karlin Curtis", Email: "lisa@example.com", Role: "owner"}},
+			CreatedAt:         Timestamp{parseTime("2021-08-17T14:28:57Z")},
+		},
+		{
+			ID:         "update-2",
+			IncidentID: "incident-1",
+			Updater:    &Actor{APIKey: &APIKey{ID: "key-1", Name: "Status bot"}},
+			CreatedAt:  Timestamp{parseTime("2021-08-17T15:28:57Z")},
+		},
+	}
+
+	if !reflect.DeepEqual(updates, expected) {
+		t.Errorf("IncidentUpdates.List returned %+v, want %+v", updates, expected)
+	}
+}
+
+func TestIncidentUpdatesService_ListAll(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/v2/incident_updates", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+
+		// Newest first, as the API returns them.
+		switch after := r.URL.Query().Get("after"); after {
+		case "":
+			_, _ = fmt.Fprint(w, `{
+				"incident_updates": [
+					{"id": "update-3", "incident_id": "incident-1", "created_at": "2021-08-17T16:00:00Z"},
+					{"id": "update-2", "incident_id": "incident-1", "created_at": "2021-08-17T15:00:00Z"}
+				],
+				"pagination_meta": {"after": "update-2", "page_size": 2}
+			}`)
+		case "update-2":
+			_, _ = fmt.Fprint(w, `{
+				"incident_updates": [
+					{"id": "update-1", "incident_id": "incident-1", "created_at": "2021-08-17T14:00:00Z"}
+				],
+				"pagination_meta": {"page_size": 2}
+			}`)
+		default:
+			t.Errorf("unexpected after cursor %q", after)
+		}
+	})
+
+	ctx := context.Background()
+	updates, _, err := client.IncidentUpdates.ListAll(ctx, &ListIncidentUpdatesOptions{
+		IncidentID:  "incident-1",
+		ListOptions: ListOptions{PageSize: 2},
+	})
+	if err != nil {
+		t.Errorf("IncidentUpdates.ListAll returned error: %v", err)
+	}
+
+	if calls != 2 {
+		t.Errorf("IncidentUpdates.ListAll made %d requests, want 2", calls)
+	}
+
+	var ids []string
+	for _, u := range updates {
+		ids = append(ids, u.ID)
+	}
+	if want := []string{"update-1", "update-2", "update-3"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("IncidentUpdates.ListAll returned %v, want %v", ids, want)
+	}
+}
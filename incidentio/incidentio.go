@@ -8,6 +8,8 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"reflect"
+	"strings"
 	"time"
 )
 
@@ -24,16 +26,27 @@ type Client struct {
 	apiKey    string
 
 	// Services used for talking to different parts of the Incident.io API.
-	Incidents     *IncidentsService
-	Severities    *SeveritiesService
-	IncidentTypes *IncidentTypesService
-	IncidentRoles *IncidentRolesService
-	CustomFields  *CustomFieldsService
-	Actions       *ActionsService
-	Workflows     *WorkflowsService
-	Schedules     *SchedulesService
-	Users         *UsersService
-	Webhooks      *WebhooksService
+	Incidents             *IncidentsService
+	IncidentUpdates       *IncidentUpdatesService
+	IncidentStatuses      *IncidentStatusesService
+	IncidentTimestamps    *IncidentTimestampsService
+	IncidentAttachments   *IncidentAttachmentsService
+	IncidentMemberships   *IncidentMembershipsService
+	IncidentSubscriptions *IncidentSubscriptionsService
+	Severities            *SeveritiesService
+	IncidentTypes         *IncidentTypesService
+	IncidentRoles         *IncidentRolesService
+	CustomFields          *CustomFieldsService
+	Actions               *ActionsService
+	FollowUps             *FollowUpsService
+	Workflows             *WorkflowsService
+	Schedules             *SchedulesService
+	Users                 *UsersService
+	Webhooks              *WebhooksService
+	Catalog               *CatalogService
+	Alerts                *AlertsService
+	AlertRoutes           *AlertRoutesService
+	Escalations           *EscalationsService
 }
 
 // ClientOption allows for functional options to configure the client.
@@ -72,15 +85,26 @@ func NewClient(apiKey string, opts ...ClientOption) *Client {
 
 	// Initialize services
 	c.Incidents = &IncidentsService{client: c}
+	c.IncidentUpdates = &IncidentUpdatesService{client: c}
+	c.IncidentStatuses = &IncidentStatusesService{client: c}
+	c.IncidentTimestamps = &IncidentTimestampsService{client: c}
+	c.IncidentAttachments = &IncidentAttachmentsService{client: c}
+	c.IncidentMemberships = &IncidentMembershipsService{client: c}
+	c.IncidentSubscriptions = &IncidentSubscriptionsService{client: c}
 	c.Severities = &SeveritiesService{client: c}
 	c.IncidentTypes = &IncidentTypesService{client: c}
 	c.IncidentRoles = &IncidentRolesService{client: c}
 	c.CustomFields = &CustomFieldsService{client: c}
 	c.Actions = &ActionsService{client: c}
+	c.FollowUps = &FollowUpsService{client: c}
 	c.Workflows = &WorkflowsService{client: c}
 	c.Schedules = &SchedulesService{client: c}
 	c.Users = &UsersService{client: c}
 	c.Webhooks = &WebhooksService{client: c}
+	c.Catalog = &CatalogService{client: c}
+	c.Alerts = &AlertsService{client: c}
+	c.AlertRoutes = &AlertRoutesService{client: c}
+	c.Escalations = &EscalationsService{client: c}
 
 	return c
 }
@@ -186,10 +210,107 @@ type ListOptions struct {
 	After    string `url:"after,omitempty"`
 }
 
+// PaginationMeta represents the pagination information returned by list endpoints.
+type PaginationMeta struct {
+	After            string `json:"after,omitempty"`
+	PageSize         int    `json:"page_size"`
+	TotalRecordCount int    `json:"total_record_count,omitempty"`
+}
+
+// addOptions adds the parameters in opts as URL query parameters to s. opts
+// must be a struct (or pointer to a struct) whose fields carry "url" tags.
+func addOptions(s string, opts interface{}) (string, error) {
+	v := reflect.ValueOf(opts)
+	if v.Kind() == reflect.Ptr && v.IsNil() {
+		return s, nil
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return s, err
+	}
+
+	qs := u.Query()
+	encodeValues(qs, reflect.Indirect(v))
+	u.RawQuery = qs.Encode()
+
+	return u.String(), nil
+}
+
+// encodeValues walks the fields of v and adds every tagged field to qs.
+// Embedded structs without a tag are flattened into the same query.
+func encodeValues(qs url.Values, v reflect.Value) {
+	t := v.Type()
+	for i := 0; i < v.NumField(); i++ {
+		sf := t.Field(i)
+		fv := v.Field(i)
+
+		tag := sf.Tag.Get("url")
+		if tag == "-" {
+			continue
+		}
+		if sf.Anonymous && tag == "" {
+			if fv.Kind() == reflect.Struct {
+				encodeValues(qs, fv)
+			}
+			continue
+		}
+
+		name, opt, _ := strings.Cut(tag, ",")
+		if name == "" || (opt == "omitempty" && fv.IsZero()) {
+			continue
+		}
+
+		if fv.Kind() == reflect.Ptr {
+			if fv.IsNil() {
+				continue
+			}
+			fv = fv.Elem()
+		}
+
+		if fv.Kind() == reflect.Slice {
+			for j := 0; j < fv.Len(); j++ {
+				qs.Add(name, fmt.Sprint(fv.Index(j).Interface()))
+			}
+			continue
+		}
+		qs.Add(name, fmt.Sprint(fv.Interface()))
+	}
+}
+
+// listAll calls fetch repeatedly, following the after cursor returned in the
+// pagination metadata, until no further pages are available.
+func listAll[T any](fetch func(after string) ([]T, *PaginationMeta, *http.Response, error)) ([]T, *http.Response, error) {
+	var (
+		all   []T
+		after string
+	)
+
+	for {
+		page, meta, resp, err := fetch(after)
+		if err != nil {
+			return nil, resp, err
+		}
+		all = append(all, page...)
+
+		if meta == nil || meta.After == "" || meta.After == after || len(page) == 0 {
+			return all, resp, nil
+		}
+		after = meta.After
+	}
+}
+
 // ExternalResource represents a common type used across the API
 type ExternalResource struct {
 	ExternalID  string `json:"external_id"`
 	DisplayName string `json:"display_name"`
+	Provider    string `json:"provider,omitempty"`
+	Permalink   string `json:"permalink,omitempty"`
+	// ResourceType identifies the kind of resource, such as a GitHub pull
+	// request. It is set on incident attachments.
+	ResourceType string `json:"resource_type,omitempty"`
+	// Title is the resource's title, as shown on incident attachments.
+	Title string `json:"title,omitempty"`
 }
 
 // Timestamp is a wrapper around time.Time to handle JSON serialization
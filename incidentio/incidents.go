@@ -18,9 +18,13 @@ type Incident struct {
 	Summary                 string                   `json:"summary,omitempty"`
 	Type                    string                   `json:"type"`
 	Status                  string                   `json:"status"`
+	IncidentStatus          *IncidentStatus          `json:"incident_status,omitempty"`
 	Severity                *Severity                `json:"severity,omitempty"`
 	IncidentRoleAssignments []IncidentRoleAssignment `json:"incident_role_assignments,omitempty"`
 	CustomFieldValues       map[string]interface{}   `json:"custom_field_values,omitempty"`
+	CustomFieldEntries      []CustomFieldEntry       `json:"custom_field_entries,omitempty"`
+	IncidentTimestampValues []IncidentTimestampValue `json:"incident_timestamp_values,omitempty"`
+	DurationMetrics         []IncidentDurationMetric `json:"duration_metrics,omitempty"`
 	CreatedAt               Timestamp                `json:"created_at"`
 	UpdatedAt               Timestamp                `json:"updated_at"`
 	ReportedAt              *Timestamp               `json:"reported_at,omitempty"`
@@ -43,11 +47,16 @@ type CreateIncidentOptions struct {
 	Summary                  string                 `json:"summary,omitempty"`
 	IncidentTypeID           string                 `json:"incident_type_id"`
 	SeverityID               string                 `json:"severity_id,omitempty"`
+	IncidentStatusID         string                 `json:"incident_status_id,omitempty"`
 	IncidentRoleAssignments  []CreateRoleAssignment `json:"incident_role_assignments,omitempty"`
 	CustomFieldValues        map[string]interface{} `json:"custom_field_values,omitempty"`
 	Mode                     string                 `json:"mode,omitempty"`
 	Visibility               string                 `json:"visibility,omitempty"`
 	SlackChannelNameOverride string                 `json:"slack_channel_name_override,omitempty"`
+
+	// CustomFieldEntries sets custom field values in the API's typed format.
+	// Build entries with SingleSelect, Text, CatalogEntries and friends.
+	CustomFieldEntries []CustomFieldEntryPayload `json:"custom_field_entries,omitempty"`
 }
 
 // List returns a list of incidents.
@@ -115,9 +124,24 @@ type UpdateIncidentOptions struct {
 	Name                    *string                `json:"name,omitempty"`
 	Summary                 *string                `json:"summary,omitempty"`
 	Status                  *string                `json:"status,omitempty"`
+	IncidentStatusID        *string                `json:"incident_status_id,omitempty"`
 	SeverityID              *string                `json:"severity_id,omitempty"`
 	IncidentRoleAssignments []CreateRoleAssignment `json:"incident_role_assignments,omitempty"`
 	CustomFieldValues       map[string]interface{} `json:"custom_field_values,omitempty"`
+
+	// CustomFieldEntries sets custom field values in the API's typed format.
+	// Fields that are not listed keep their values.
+	CustomFieldEntries []CustomFieldEntryPayload `json:"custom_field_entries,omitempty"`
+
+	// MergedIntoIncidentID is the incident this one is merged into, when
+	// moving it to a merged status.
+	MergedIntoIncidentID *string `json:"merged_into_incident_id,omitempty"`
+	// Message is posted as an incident update alongside the change.
+	Message *string `json:"message,omitempty"`
+
+	// IncidentTimestampValues sets incident timestamps. Timestamps that are
+	// not listed keep their values.
+	IncidentTimestampValues []IncidentTimestampValuePayload `json:"incident_timestamp_values,omitempty"`
 }
 
 // Update updates an incident.
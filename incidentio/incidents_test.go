@@ -493,3 +493,43 @@ func TestErrorResponse_Error(t *testing.T) {
 		t.Errorf("ErrorResponse.Error() = %q, want %q", got, want)
 	}
 }
+
+func TestAddOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		opts interface{}
+		want string
+	}{
+		{
+			name: "nil options",
+			opts: (*ListActionsOptions)(nil),
+			want: "v2/actions",
+		},
+		{
+			name: "empty options",
+			opts: &ListActionsOptions{},
+			want: "v2/actions",
+		},
+		{
+			name: "embedded list options",
+			opts: &ListActionsOptions{
+				IncidentID:  "01FDAG4SAP5TYPT98WGR2N7W91",
+				ListOptions: ListOptions{PageSize: 25, After: "01FCNDV6P870EA6S7TK1DSYDG0"},
+			},
+			want: "v2/actions?after=01FCNDV6P870EA6S7TK1DSYDG0&incident_id=01FDAG4SAP5TYPT98WGR2N7W91&page_size=25",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := addOptions("v2/actions", tt.opts)
+			if err != nil {
+				t.Errorf("addOptions() error = %v", err)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("addOptions() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,3 @@
+// Package lifecycle checks incident status changes against the allowed
+// transitions between status categories before they are sent to the API.
+package lifecycle
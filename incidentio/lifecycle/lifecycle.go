@@ -0,0 +1,253 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/cpanato/go-incident-io/incidentio"
+)
+
+// transitions maps each status category to the categories an incident may
+// move to from it. Moving between statuses of the same category is always
+// allowed. Declined, merged and canceled incidents cannot be moved again.
+var transitions = map[string][]string{
+	incidentio.IncidentStatusCategoryTriage: {
+		incidentio.IncidentStatusCategoryLive,
+		incidentio.IncidentStatusCategoryDeclined,
+		incidentio.IncidentStatusCategoryMerged,
+		incidentio.IncidentStatusCategoryCanceled,
+	},
+	incidentio.IncidentStatusCategoryLive: {
+		incidentio.IncidentStatusCategoryPaused,
+		incidentio.IncidentStatusCategoryLearning,
+		incidentio.IncidentStatusCategoryClosed,
+		incidentio.IncidentStatusCategoryMerged,
+	},
+	incidentio.IncidentStatusCategoryPaused: {
+		incidentio.IncidentStatusCategoryLive,
+		incidentio.IncidentStatusCategoryLearning,
+		incidentio.IncidentStatusCategoryClosed,
+	},
+	incidentio.IncidentStatusCategoryLearning: {
+		incidentio.IncidentStatusCategoryLive,
+		incidentio.IncidentStatusCategoryClosed,
+	},
+	incidentio.IncidentStatusCategoryClosed: {
+		incidentio.IncidentStatusCategoryLive,
+	},
+}
+
+// ErrNoStatus is returned when the organization has no status of the
+// category an intent needs.
+var ErrNoStatus = errors.New("lifecycle: no incident status of the required category")
+
+// TransitionError reports a status change that is not allowed.
+type TransitionError struct {
+	From string
+	To   string
+}
+
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("lifecycle: cannot move incident from %s to %s", e.From, e.To)
+}
+
+// Allowed reports whether an incident may move from one status category to
+// another.
+func Allowed(from, to string) bool {
+	if from == to {
+		return true
+	}
+	for _, c := range transitions[from] {
+		if c == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate checks that opts moves incident to a status its current status
+// allows. statuses are the organization's incident statuses, used to find
+// the category of opts.IncidentStatusID. A legacy opts.Status is taken to be
+// a category. Updates that do not change the status are always valid.
+func Validate(incident *incidentio.Incident, opts *incidentio.UpdateIncidentOptions, statuses []*incidentio.IncidentStatus) error {
+	if opts == nil {
+		return nil
+	}
+
+	var to string
+	switch {
+	case opts.IncidentStatusID != nil:
+		status := findStatus(statuses, *opts.IncidentStatusID)
+		if status == nil {
+			return fmt.Errorf("lifecycle: unknown incident status %q", *opts.IncidentStatusID)
+		}
+		to = status.Category
+	case opts.Status != nil:
+		to = *opts.Status
+	default:
+		return nil
+	}
+
+	from := incident.StatusCategory()
+	if !Allowed(from, to) {
+		return &TransitionError{From: from, To: to}
+	}
+	if to == incidentio.IncidentStatusCategoryMerged && (opts.MergedIntoIncidentID == nil || *opts.MergedIntoIncidentID == "") {
+		return errors.New("lifecycle: merging an incident requires the incident to merge into")
+	}
+
+	return nil
+}
+
+// Manager makes validated status changes through a client, choosing the
+// status for an intent such as resolving or declining an incident.
+type Manager struct {
+	client *incidentio.Client
+
+	mu       sync.Mutex
+	statuses []*incidentio.IncidentStatus
+}
+
+// NewManager returns a Manager using client. The organization's incident
+// statuses are fetched on first use and cached.
+func NewManager(client *incidentio.Client) *Manager {
+	return &Manager{client: client}
+}
+
+// Update validates opts against the current state of the incident and
+// applies it.
+func (m *Manager) Update(ctx context.Context, id string, opts *incidentio.UpdateIncidentOptions) (*incidentio.Incident, *http.Response, error) {
+	statuses, resp, err := m.Statuses(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	incident, resp, err := m.client.Incidents.Get(ctx, id)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err := Validate(incident, opts, statuses); err != nil {
+		return nil, nil, err
+	}
+
+	return m.client.Incidents.Update(ctx, id, opts)
+}
+
+// Resolve ends the active phase of an incident. It moves the incident into
+// the post-incident flow when the organization has a learning status, and
+// closes it otherwise. A learning incident is closed.
+func (m *Manager) Resolve(ctx context.Context, id, message string) (*incidentio.Incident, *http.Response, error) {
+	statuses, resp, err := m.Statuses(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	incident, resp, err := m.client.Incidents.Get(ctx, id)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	status := firstOf(statuses, incidentio.IncidentStatusCategoryLearning)
+	if status == nil || incident.StatusCategory() == incidentio.IncidentStatusCategoryLearning {
+		status = firstOf(statuses, incidentio.IncidentStatusCategoryClosed)
+	}
+	if status == nil {
+		return nil, nil, ErrNoStatus
+	}
+
+	opts := &incidentio.UpdateIncidentOptions{IncidentStatusID: &status.ID}
+	if message != "" {
+		opts.Message = &message
+	}
+	if err := Validate(incident, opts, statuses); err != nil {
+		return nil, nil, err
+	}
+
+	return m.client.Incidents.Update(ctx, id, opts)
+}
+
+// Decline declines an incident in triage.
+func (m *Manager) Decline(ctx context.Context, id, message string) (*incidentio.Incident, *http.Response, error) {
+	return m.moveTo(ctx, id, incidentio.IncidentStatusCategoryDeclined, nil, message)
+}
+
+// Merge merges an incident into the incident with ID into.
+func (m *Manager) Merge(ctx context.Context, id, into, message string) (*incidentio.Incident, *http.Response, error) {
+	return m.moveTo(ctx, id, incidentio.IncidentStatusCategoryMerged, &into, message)
+}
+
+// Statuses returns the organization's incident statuses, fetching them on
+// first use.
+func (m *Manager) Statuses(ctx context.Context) ([]*incidentio.IncidentStatus, *http.Response, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.statuses != nil {
+		return m.statuses, nil, nil
+	}
+
+	statuses, resp, err := m.client.IncidentStatuses.List(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+	m.statuses = statuses
+
+	return statuses, resp, nil
+}
+
+func (m *Manager) moveTo(ctx context.Context, id, category string, mergedInto *string, message string) (*incidentio.Incident, *http.Response, error) {
+	statuses, resp, err := m.Statuses(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	status := firstOf(statuses, category)
+	if status == nil {
+		return nil, nil, fmt.Errorf("%w: %s", ErrNoStatus, category)
+	}
+
+	opts := &incidentio.UpdateIncidentOptions{
+		IncidentStatusID:     &status.ID,
+		MergedIntoIncidentID: mergedInto,
+	}
+	if message != "" {
+		opts.Message = &message
+	}
+
+	return m.Update(ctx, id, opts)
+}
+
+func findStatus(statuses []*incidentio.IncidentStatus, id string) *incidentio.IncidentStatus {
+	for _, s := range statuses {
+		if s.ID == id {
+			return s
+		}
+	}
+
+	return nil
+}
+
+// firstOf returns the lowest ranked status of category.
+func firstOf(statuses []*incidentio.IncidentStatus, category string) *incidentio.IncidentStatus {
+	var matching []*incidentio.IncidentStatus
+	for _, s := range statuses {
+		if s.Category == category {
+			matching = append(matching, s)
+		}
+	}
+	if len(matching) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(matching, func(i, j int) bool {
+		return matching[i].Rank < matching[j].Rank
+	})
+
+	return matching[0]
+}
@@ -0,0 +1,195 @@
+package lifecycle
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cpanato/go-incident-io/incidentio"
+)
+
+var statuses = []*incidentio.IncidentStatus{
+	{ID: "status-triage", Name: "Triage", Category: incidentio.IncidentStatusCategoryTriage, Rank: 0},
+	{ID: "status-investigating", Name: "Investigating", Category: incidentio.IncidentStatusCategoryLive, Rank: 1},
+	{ID: "status-fixing", Name: "Fixing", Category: incidentio.IncidentStatusCategoryLive, Rank: 2},
+	{ID: "status-postmortem", Name: "Post-mortem", Category: incidentio.IncidentStatusCategoryLearning, Rank: 3},
+	{ID: "status-done", Name: "All sorted", Category: incidentio.IncidentStatusCategoryClosed, Rank: 4},
+	{ID: "status-declined", Name: "Declined", Category: incidentio.IncidentStatusCategoryDeclined, Rank: 5},
+	{ID: "status-merged", Name: "Merged", Category: incidentio.IncidentStatusCategoryMerged, Rank: 6},
+}
+
+func incidentIn(category string) *incidentio.Incident {
+	for _, s := range statuses {
+		if s.Category == category {
+			return &incidentio.Incident{ID: "incident-1", IncidentStatus: s}
+		}
+	}
+
+	return &incidentio.Incident{ID: "incident-1", Status: category}
+}
+
+func TestValidate(t *testing.T) {
+	str := func(s string) *string { return &s }
+
+	tests := []struct {
+		name    string
+		from    string
+		opts    *incidentio.UpdateIncidentOptions
+		wantErr bool
+	}{
+		{"no status change", incidentio.IncidentStatusCategoryClosed, &incidentio.UpdateIncidentOptions{Name: str("Renamed")}, false},
+		{"triage to live", incidentio.IncidentStatusCategoryTriage, &incidentio.UpdateIncidentOptions{IncidentStatusID: str("status-investigating")}, false},
+		{"within live", incidentio.IncidentStatusCategoryLive, &incidentio.UpdateIncidentOptions{IncidentStatusID: str("status-fixing")}, false},
+		{"live to learning", incidentio.IncidentStatusCategoryLive, &incidentio.UpdateIncidentOptions{IncidentStatusID: str("status-postmortem")}, false},
+		{"learning to closed", incidentio.IncidentStatusCategoryLearning, &incidentio.UpdateIncidentOptions{IncidentStatusID: str("status-done")}, false},
+		{"triage to closed", incidentio.IncidentStatusCategoryTriage, &incidentio.UpdateIncidentOptions{IncidentStatusID: str("status-done")}, true},
+		{"live to declined", incidentio.IncidentStatusCategoryLive, &incidentio.UpdateIncidentOptions{IncidentStatusID: str("status-declined")}, true},
+		{"declined is terminal", incidentio.IncidentStatusCategoryDeclined, &incidentio.UpdateIncidentOptions{IncidentStatusID: str("status-investigating")}, true},
+		{"merged is terminal", incidentio.IncidentStatusCategoryMerged, &incidentio.UpdateIncidentOptions{IncidentStatusID: str("status-investigating")}, true},
+		{"merge without target", incidentio.IncidentStatusCategoryTriage, &incidentio.UpdateIncidentOptions{IncidentStatusID: str("status-merged")}, true},
+		{"merge with target", incidentio.IncidentStatusCategoryTriage, &incidentio.UpdateIncidentOptions{IncidentStatusID: str("status-merged"), MergedIntoIncidentID: str("incident-2")}, false},
+		{"unknown status", incidentio.IncidentStatusCategoryLive, &incidentio.UpdateIncidentOptions{IncidentStatusID: str("status-unknown")}, true},
+		{"legacy status", "triage", &incidentio.UpdateIncidentOptions{Status: str("closed")}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(incidentIn(tt.from), tt.opts, statuses)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate returned %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidate_TransitionError(t *testing.T) {
+	id := "status-done"
+	err := Validate(incidentIn(incidentio.IncidentStatusCategoryTriage), &incidentio.UpdateIncidentOptions{IncidentStatusID: &id}, statuses)
+
+	var terr *TransitionError
+	if !errors.As(err, &terr) {
+		t.Fatalf("Validate returned %v, want a *TransitionError", err)
+	}
+	if terr.From != incidentio.IncidentStatusCategoryTriage || terr.To != incidentio.IncidentStatusCategoryClosed {
+		t.Errorf("TransitionError = %+v, want triage to closed", terr)
+	}
+}
+
+// setup returns a Manager talking to a fake API holding one incident in the
+// given category. The returned pointer holds the last update body received.
+func setup(t *testing.T, category string, available []*incidentio.IncidentStatus) (*Manager, *map[string]interface{}) {
+	t.Helper()
+
+	var updated map[string]interface{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/incident_statuses", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"incident_statuses": available})
+	})
+	mux.HandleFunc("/v2/incidents/incident-1", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"incident": incidentIn(category)})
+		case "PUT":
+			if err := json.NewDecoder(r.Body).Decode(&updated); err != nil {
+				t.Errorf("error decoding request body: %v", err)
+			}
+			_, _ = fmt.Fprint(w, `{"incident": {"id": "incident-1"}}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client := incidentio.NewClient("test-key", incidentio.WithBaseURL(server.URL+"/"))
+
+	return NewManager(client), &updated
+}
+
+func TestManager_Resolve(t *testing.T) {
+	tests := []struct {
+		name      string
+		category  string
+		available []*incidentio.IncidentStatus
+		want      string
+	}{
+		{"live with post-incident flow", incidentio.IncidentStatusCategoryLive, statuses, "status-postmortem"},
+		{"learning", incidentio.IncidentStatusCategoryLearning, statuses, "status-done"},
+		{"live without post-incident flow", incidentio.IncidentStatusCategoryLive, []*incidentio.IncidentStatus{statuses[1], statuses[4]}, "status-done"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, updated := setup(t, tt.category, tt.available)
+
+			if _, _, err := m.Resolve(context.Background(), "incident-1", "Rolled back the deploy"); err != nil {
+				t.Fatalf("Resolve returned error: %v", err)
+			}
+
+			if got := (*updated)["incident_status_id"]; got != tt.want {
+				t.Errorf("incident_status_id = %v, want %s", got, tt.want)
+			}
+			if got := (*updated)["message"]; got != "Rolled back the deploy" {
+				t.Errorf("message = %v, want the resolution message", got)
+			}
+		})
+	}
+}
+
+func TestManager_Decline(t *testing.T) {
+	m, updated := setup(t, incidentio.IncidentStatusCategoryTriage, statuses)
+
+	if _, _, err := m.Decline(context.Background(), "incident-1", ""); err != nil {
+		t.Fatalf("Decline returned error: %v", err)
+	}
+
+	if got := (*updated)["incident_status_id"]; got != "status-declined" {
+		t.Errorf("incident_status_id = %v, want status-declined", got)
+	}
+	if _, ok := (*updated)["message"]; ok {
+		t.Error("message was sent, want it omitted")
+	}
+}
+
+func TestManager_DeclineLive(t *testing.T) {
+	m, updated := setup(t, incidentio.IncidentStatusCategoryLive, statuses)
+
+	_, _, err := m.Decline(context.Background(), "incident-1", "")
+	var terr *TransitionError
+	if !errors.As(err, &terr) {
+		t.Fatalf("Decline returned %v, want a *TransitionError", err)
+	}
+
+	if *updated != nil {
+		t.Errorf("incident was updated with %v, want no request", *updated)
+	}
+}
+
+func TestManager_Merge(t *testing.T) {
+	m, updated := setup(t, incidentio.IncidentStatusCategoryTriage, statuses)
+
+	if _, _, err := m.Merge(context.Background(), "incident-1", "incident-2", "Duplicate"); err != nil {
+		t.Fatalf("Merge returned error: %v", err)
+	}
+
+	if got := (*updated)["incident_status_id"]; got != "status-merged" {
+		t.Errorf("incident_status_id = %v, want status-merged", got)
+	}
+	if got := (*updated)["merged_into_incident_id"]; got != "incident-2" {
+		t.Errorf("merged_into_incident_id = %v, want incident-2", got)
+	}
+}
+
+func TestManager_NoStatus(t *testing.T) {
+	m, _ := setup(t, incidentio.IncidentStatusCategoryTriage, statuses[:3])
+
+	_, _, err := m.Decline(context.Background(), "incident-1", "")
+	if !errors.Is(err, ErrNoStatus) {
+		t.Errorf("Decline returned %v, want ErrNoStatus", err)
+	}
+}
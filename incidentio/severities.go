@@ -2,7 +2,9 @@ package incidentio
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"sort"
 )
 
 // SeveritiesService handles communication with the severity related methods.
@@ -10,6 +12,22 @@ type SeveritiesService struct {
 	client *Client
 }
 
+// CreateSeverityOptions represents options for creating a severity.
+type CreateSeverityOptions struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// Rank orders severities, higher ranks being more severe. When nil, the
+	// API decides where the new severity is ranked.
+	Rank *int `json:"rank,omitempty"`
+}
+
+// UpdateSeverityOptions represents options for updating a severity.
+type UpdateSeverityOptions struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Rank        *int    `json:"rank,omitempty"`
+}
+
 // List returns a list of severities.
 func (s *SeveritiesService) List(ctx context.Context) ([]*Severity, *http.Response, error) {
 	u := "v1/severities"
@@ -29,3 +47,104 @@ func (s *SeveritiesService) List(ctx context.Context) ([]*Severity, *http.Respon
 
 	return result.Severities, resp, nil
 }
+
+// Get returns a single severity.
+func (s *SeveritiesService) Get(ctx context.Context, id string) (*Severity, *http.Response, error) {
+	u := fmt.Sprintf("v1/severities/%s", id)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		Severity *Severity `json:"severity"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.Severity, resp, nil
+}
+
+// Create creates a new severity.
+func (s *SeveritiesService) Create(ctx context.Context, opts *CreateSeverityOptions) (*Severity, *http.Response, error) {
+	u := "v1/severities"
+
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		Severity *Severity `json:"severity"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.Severity, resp, nil
+}
+
+// Update updates a severity.
+func (s *SeveritiesService) Update(ctx context.Context, id string, opts *UpdateSeverityOptions) (*Severity, *http.Response, error) {
+	u := fmt.Sprintf("v1/severities/%s", id)
+
+	req, err := s.client.NewRequest("PUT", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		Severity *Severity `json:"severity"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.Severity, resp, nil
+}
+
+// Delete deletes a severity.
+func (s *SeveritiesService) Delete(ctx context.Context, id string) (*http.Response, error) {
+	u := fmt.Sprintf("v1/severities/%s", id)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// CompareSeverities orders severities by rank. It returns a negative number
+// when a is less severe than b, a positive number when it is more severe, and
+// zero when they rank the same. A nil severity is less severe than any other.
+func CompareSeverities(a, b *Severity) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+
+	return a.Rank - b.Rank
+}
+
+// SortSeverities sorts severities from least to most severe.
+func SortSeverities(severities []*Severity) {
+	sort.SliceStable(severities, func(i, j int) bool {
+		return CompareSeverities(severities[i], severities[j]) < 0
+	})
+}
+
+// AtLeast reports whether s is at least as severe as threshold, e.g. whether
+// an incident's severity is "at least Major".
+func (s *Severity) AtLeast(threshold *Severity) bool {
+	return CompareSeverities(s, threshold) >= 0
+}
@@ -0,0 +1,242 @@
+package incidentio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestSeveritiesService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/severities", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Authorization", "Bearer test-key")
+
+		_, _ = fmt.Fprint(w, `{
+			"severities": [
+				{"id": "sev-minor", "name": "Minor", "description": "Issues with low impact.", "rank": 1},
+				{"id": "sev-critical", "name": "Critical", "description": "Issues causing significant impact.", "rank": 3}
+			]
+		}`)
+	})
+
+	ctx := context.Background()
+	severities, _, err := client.Severities.List(ctx)
+	if err != nil {
+		t.Errorf("Severities.List returned error: %v", err)
+	}
+
+	expected := []*Severity{
+		{ID: "sev-minor", Name: "Minor", Description: "Issues with low impact.", Rank: 1},
+		{ID: "sev-critical", Name: "Critical", Description: "Issues causing significant impact.", Rank: 3},
+	}
+
+	if !reflect.DeepEqual(severities, expected) {
+		t.Errorf("Severities.List returned %+v, want %+v", severities, expected)
+	}
+}
+
+func TestSeveritiesService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/severities/sev-major", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		_, _ = fmt.Fprint(w, `{
+			"severity": {
+				"id": "sev-major",
+				"name": "Major",
+				"description": "Issues with significant customer impact.",
+				"rank": 2,
+				"created_at": "2021-08-17T13:28:57Z",
+				"updated_at": "2021-08-17T13:28:57Z"
+			}
+		}`)
+	})
+
+	ctx := context.Background()
+	severity, _, err := client.Severities.Get(ctx, "sev-major")
+	if err != nil {
+		t.Errorf("Severities.Get returned error: %v", err)
+	}
+
+	expected := &Severity{
+		ID:          "sev-major",
+		Name:        "Major",
+		Description: "Issues with significant customer impact.",
+		Rank:        2,
+		CreatedAt:   Timestamp{parseTime("2021-08-17T13:28:57Z")},
+		UpdatedAt:   Timestamp{parseTime("2021-08-17T13:28:57Z")},
+	}
+
+	if !reflect.DeepEqual(severity, expected) {
+		t.Errorf("Severities.Get returned %+v, want %+v", severity, expected)
+	}
+}
+
+func TestSeveritiesService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	rank := 4
+	input := &CreateSeverityOptions{
+		Name:        "Emergency",
+		Description: "All hands on deck.",
+		Rank:        &rank,
+	}
+
+	mux.HandleFunc("/v1/severities", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", "application/json")
+
+		var received CreateSeverityOptions
+		err := json.NewDecoder(r.Body).Decode(&received)
+		if err != nil {
+			t.Errorf("error decoding request body: %v", err)
+		}
+
+		if !reflect.DeepEqual(received, *input) {
+			t.Errorf("Request body = %+v, want %+v", received, *input)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `{"severity": {"id": "sev-emergency", "name": "Emergency", "description": "All hands on deck.", "rank": 4}}`)
+	})
+
+	ctx := context.Background()
+	severity, resp, err := client.Severities.Create(ctx, input)
+	if err != nil {
+		t.Errorf("Severities.Create returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Errorf("Severities.Create returned status %d, want %d", resp.StatusCode, http.StatusCreated)
+	}
+
+	if severity.Rank != 4 {
+		t.Errorf("Severities.Create returned Rank %d, want 4", severity.Rank)
+	}
+}
+
+func TestSeveritiesService_Create_DefaultRank(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/severities", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("error decoding request body: %v", err)
+		}
+
+		if _, ok := body["rank"]; ok {
+			t.Errorf("Request body has rank %v, want it omitted", body["rank"])
+		}
+
+		_, _ = fmt.Fprint(w, `{"severity": {"id": "sev-new", "name": "New", "rank": 5}}`)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Severities.Create(ctx, &CreateSeverityOptions{Name: "New"}); err != nil {
+		t.Errorf("Severities.Create returned error: %v", err)
+	}
+}
+
+func TestSeveritiesService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	name := "Sev 1"
+	input := &UpdateSeverityOptions{Name: &name}
+
+	mux.HandleFunc("/v1/severities/sev-critical", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+
+		var received UpdateSeverityOptions
+		err := json.NewDecoder(r.Body).Decode(&received)
+		if err != nil {
+			t.Errorf("error decoding request body: %v", err)
+		}
+
+		if !reflect.DeepEqual(received, *input) {
+			t.Errorf("Request body = %+v, want %+v", received, *input)
+		}
+
+		_, _ = fmt.Fprint(w, `{"severity": {"id": "sev-critical", "name": "Sev 1", "rank": 3}}`)
+	})
+
+	ctx := context.Background()
+	severity, _, err := client.Severities.Update(ctx, "sev-critical", input)
+	if err != nil {
+		t.Errorf("Severities.Update returned error: %v", err)
+	}
+
+	if severity.Name != "Sev 1" {
+		t.Errorf("Severities.Update returned Name %s, want Sev 1", severity.Name)
+	}
+}
+
+func TestSeveritiesService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/severities/sev-critical", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	resp, err := client.Severities.Delete(ctx, "sev-critical")
+	if err != nil {
+		t.Errorf("Severities.Delete returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Severities.Delete returned status %d, want %d", resp.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestSortSeverities(t *testing.T) {
+	minor := &Severity{Name: "Minor", Rank: 1}
+	major := &Severity{Name: "Major", Rank: 2}
+	critical := &Severity{Name: "Critical", Rank: 3}
+
+	severities := []*Severity{critical, minor, nil, major}
+	SortSeverities(severities)
+
+	expected := []*Severity{nil, minor, major, critical}
+	if !reflect.DeepEqual(severities, expected) {
+		t.Errorf("SortSeverities returned %+v, want %+v", severities, expected)
+	}
+}
+
+func TestSeverity_AtLeast(t *testing.T) {
+	minor := &Severity{Name: "Minor", Rank: 1}
+	major := &Severity{Name: "Major", Rank: 2}
+	critical := &Severity{Name: "Critical", Rank: 3}
+
+	tests := []struct {
+		severity *Severity
+		want     bool
+	}{
+		{critical, true},
+		{major, true},
+		{minor, false},
+		{nil, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.severity.AtLeast(major); got != tt.want {
+			t.Errorf("%+v.AtLeast(Major) = %v, want %v", tt.severity, got, tt.want)
+		}
+	}
+
+	if CompareSeverities(nil, nil) != 0 {
+		t.Error("CompareSeverities(nil, nil) != 0")
+	}
+}
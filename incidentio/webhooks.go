@@ -1,5 +1,11 @@
 package incidentio
 
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
 // WebhooksService handles communication with the webhooks related methods.
 type WebhooksService struct {
 	client *Client
@@ -11,6 +17,163 @@ type Webhook struct {
 	Name             string    `json:"name"`
 	Endpoint         string    `json:"endpoint"`
 	PrivateIncidents bool      `json:"private_incidents"`
+	EventTypes       []string  `json:"event_types,omitempty"`
 	CreatedAt        Timestamp `json:"created_at"`
 	UpdatedAt        Timestamp `json:"updated_at"`
 }
+
+// WebhookSecret represents the signing secret of a webhook endpoint.
+type WebhookSecret struct {
+	Secret    string    `json:"secret"`
+	CreatedAt Timestamp `json:"created_at"`
+}
+
+// CreateWebhookOptions represents options for creating a webhook.
+type CreateWebhookOptions struct {
+	Name             string   `json:"name"`
+	Endpoint         string   `json:"endpoint"`
+	PrivateIncidents bool     `json:"private_incidents"`
+	EventTypes       []string `json:"event_types,omitempty"`
+}
+
+// UpdateWebhookOptions represents options for updating a webhook.
+type UpdateWebhookOptions struct {
+	Name             *string  `json:"name,omitempty"`
+	Endpoint         *string  `json:"endpoint,omitempty"`
+	PrivateIncidents *bool    `json:"private_incidents,omitempty"`
+	EventTypes       []string `json:"event_types,omitempty"`
+}
+
+// List returns a list of webhooks.
+func (s *WebhooksService) List(ctx context.Context) ([]*Webhook, *http.Response, error) {
+	u := "v2/webhooks"
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		Webhooks []*Webhook `json:"webhooks"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.Webhooks, resp, nil
+}
+
+// Get returns a single webhook.
+func (s *WebhooksService) Get(ctx context.Context, id string) (*Webhook, *http.Response, error) {
+	u := fmt.Sprintf("v2/webhooks/%s", id)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		Webhook *Webhook `json:"webhook"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.Webhook, resp, nil
+}
+
+// Create creates a new webhook.
+func (s *WebhooksService) Create(ctx context.Context, opts *CreateWebhookOptions) (*Webhook, *http.Response, error) {
+	u := "v2/webhooks"
+
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		Webhook *Webhook `json:"webhook"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.Webhook, resp, nil
+}
+
+// Update updates a webhook.
+func (s *WebhooksService) Update(ctx context.Context, id string, opts *UpdateWebhookOptions) (*Webhook, *http.Response, error) {
+	u := fmt.Sprintf("v2/webhooks/%s", id)
+
+	req, err := s.client.NewRequest("PUT", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		Webhook *Webhook `json:"webhook"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.Webhook, resp, nil
+}
+
+// Delete deletes a webhook.
+func (s *WebhooksService) Delete(ctx context.Context, id string) (*http.Response, error) {
+	u := fmt.Sprintf("v2/webhooks/%s", id)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// GetSecret returns the signing secret of a webhook.
+func (s *WebhooksService) GetSecret(ctx context.Context, id string) (*WebhookSecret, *http.Response, error) {
+	u := fmt.Sprintf("v2/webhooks/%s/secret", id)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		Secret *WebhookSecret `json:"secret"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.Secret, resp, nil
+}
+
+// RotateSecret replaces the signing secret of a webhook and returns the new
+// secret. Keep accepting the previous secret until deliveries signed with it
+// have drained.
+func (s *WebhooksService) RotateSecret(ctx context.Context, id string) (*WebhookSecret, *http.Response, error) {
+	u := fmt.Sprintf("v2/webhooks/%s/actions/rotate_secret", id)
+
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		Secret *WebhookSecret `json:"secret"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.Secret, resp, nil
+}
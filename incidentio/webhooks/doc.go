@@ -0,0 +1,2 @@
+// Package webhooks receives and verifies Incident.io webhook deliveries.
+package webhooks
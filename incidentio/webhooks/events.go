@@ -0,0 +1,110 @@
+package webhooks
+
+import (
+	"encoding/json"
+
+	"github.com/cpanato/go-incident-io/incidentio"
+)
+
+// Event types sent by Incident.io.
+const (
+	EventTypeIncidentCreated       = "public_incident.incident_created_v2"
+	EventTypeIncidentUpdated       = "public_incident.incident_updated_v2"
+	EventTypeIncidentStatusUpdated = "public_incident.incident_status_updated_v2"
+	EventTypeFollowUpCreated       = "public_incident.follow_up_created_v1"
+	EventTypeFollowUpUpdated       = "public_incident.follow_up_updated_v1"
+	EventTypeActionCreated         = "public_incident.action_created_v1"
+	EventTypeActionUpdated         = "public_incident.action_updated_v1"
+
+	EventTypePrivateIncidentCreated       = "private_incident.incident_created_v2"
+	EventTypePrivateIncidentUpdated       = "private_incident.incident_updated_v2"
+	EventTypePrivateIncidentStatusUpdated = "private_incident.incident_status_updated_v2"
+	EventTypePrivateFollowUpCreated       = "private_incident.follow_up_created_v1"
+	EventTypePrivateFollowUpUpdated       = "private_incident.follow_up_updated_v1"
+	EventTypePrivateActionCreated         = "private_incident.action_created_v1"
+	EventTypePrivateActionUpdated         = "private_incident.action_updated_v1"
+)
+
+// IncidentCreatedEvent is sent when a public incident is created.
+type IncidentCreatedEvent struct {
+	Incident *incidentio.Incident
+}
+
+// UnmarshalJSON decodes the incident carried by the event.
+func (e *IncidentCreatedEvent) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &e.Incident)
+}
+
+// IncidentUpdatedEvent is sent when a public incident is updated.
+type IncidentUpdatedEvent struct {
+	Incident *incidentio.Incident
+}
+
+// UnmarshalJSON decodes the incident carried by the event.
+func (e *IncidentUpdatedEvent) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &e.Incident)
+}
+
+// IncidentStatusUpdatedEvent is sent when the status of a public incident changes.
+type IncidentStatusUpdatedEvent struct {
+	Incident       *incidentio.Incident       `json:"incident"`
+	NewStatus      *incidentio.IncidentStatus `json:"new_status"`
+	PreviousStatus *incidentio.IncidentStatus `json:"previous_status"`
+}
+
+// FollowUpCreatedEvent is sent when a follow-up is created on a public incident.
+type FollowUpCreatedEvent struct {
+	FollowUp *incidentio.FollowUp
+}
+
+// UnmarshalJSON decodes the follow-up carried by the event.
+func (e *FollowUpCreatedEvent) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &e.FollowUp)
+}
+
+// FollowUpUpdatedEvent is sent when a follow-up on a public incident is updated.
+type FollowUpUpdatedEvent struct {
+	FollowUp *incidentio.FollowUp
+}
+
+// UnmarshalJSON decodes the follow-up carried by the event.
+func (e *FollowUpUpdatedEvent) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &e.FollowUp)
+}
+
+// ActionCreatedEvent is sent when an action is created on a public incident.
+type ActionCreatedEvent struct {
+	Action *incidentio.Action
+}
+
+// UnmarshalJSON decodes the action carried by the event.
+func (e *ActionCreatedEvent) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &e.Action)
+}
+
+// ActionUpdatedEvent is sent when an action on a public incident is updated.
+type ActionUpdatedEvent struct {
+	Action *incidentio.Action
+}
+
+// UnmarshalJSON decodes the action carried by the event.
+func (e *ActionUpdatedEvent) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &e.Action)
+}
+
+// PrivateIncidentEvent is sent for changes to private incidents. Private
+// deliveries only identify the resource; fetch it through the API to see
+// its details.
+type PrivateIncidentEvent struct {
+	ID string `json:"id"`
+}
+
+// PrivateFollowUpEvent is sent for changes to follow-ups of private incidents.
+type PrivateFollowUpEvent struct {
+	ID string `json:"id"`
+}
+
+// PrivateActionEvent is sent for changes to actions of private incidents.
+type PrivateActionEvent struct {
+	ID string `json:"id"`
+}
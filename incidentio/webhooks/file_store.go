@@ -0,0 +1,204 @@
+package webhooks
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// FileStore is a DeliveryStore that appends deliveries to a JSON lines file,
+// so that deduplication and replay survive restarts. The file is never
+// compacted; rotate it externally if it grows too large. Claims are held in
+// memory only.
+type FileStore struct {
+	mu      sync.Mutex
+	path    string
+	file    storeFile
+	seen    map[string]time.Time
+	claimed map[string]struct{}
+}
+
+// storeFile is the part of *os.File a FileStore writes through.
+type storeFile interface {
+	io.Writer
+	io.Closer
+	Stat() (os.FileInfo, error)
+	Sync() error
+	Truncate(size int64) error
+}
+
+// OpenFileStore opens, or creates, the store file at path and loads the IDs
+// of the deliveries it already holds. A final line left incomplete by a
+// crash during a write is discarded.
+func OpenFileStore(path string) (*FileStore, error) {
+	cleaned := filepath.Clean(path)
+	s := &FileStore{
+		path:    cleaned,
+		seen:    make(map[string]time.Time),
+		claimed: make(map[string]struct{}),
+	}
+
+	size, err := s.scan(func(d *Delivery) {
+		s.seen[d.Event.ID] = d.ReceivedAt
+	})
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	s.file, err = os.OpenFile(cleaned, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	// Drop a torn final line so that new records start on a line of their own.
+	if info, err := s.file.Stat(); err == nil && info.Size() > size {
+		if err := s.file.Truncate(size); err != nil {
+			_ = s.file.Close()
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+// Close closes the store file.
+func (s *FileStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.file.Close()
+}
+
+// Seen implements DeliveryStore.
+func (s *FileStore) Seen(_ context.Context, id string, since time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	receivedAt, ok := s.seen[id]
+
+	return ok && !receivedAt.Before(since), nil
+}
+
+// Claim implements DeliveryStore.
+func (s *FileStore) Claim(_ context.Context, id string, since time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.claimed[id]; ok {
+		return false, nil
+	}
+	if receivedAt, ok := s.seen[id]; ok && !receivedAt.Before(since) {
+		return false, nil
+	}
+	s.claimed[id] = struct{}{}
+
+	return true, nil
+}
+
+// Release implements DeliveryStore.
+func (s *FileStore) Release(_ context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.claimed, id)
+
+	return nil
+}
+
+// Record implements DeliveryStore.
+func (s *FileStore) Record(_ context.Context, delivery *Delivery) error {
+	line, err := json.Marshal(delivery)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.claimed, delivery.Event.ID)
+
+	info, err := s.file.Stat()
+	if err != nil {
+		return err
+	}
+
+	if err := s.write(append(line, '\n')); err != nil {
+		// Drop whatever part of the line was written, so that the next
+		// record does not continue it and corrupt the middle of the file.
+		if terr := s.file.Truncate(info.Size()); terr != nil {
+			return errors.Join(err, terr)
+		}
+		return err
+	}
+	s.seen[delivery.Event.ID] = delivery.ReceivedAt
+
+	return nil
+}
+
+func (s *FileStore) write(line []byte) error {
+	if _, err := s.file.Write(line); err != nil {
+		return err
+	}
+
+	return s.file.Sync()
+}
+
+// Deliveries implements DeliveryStore.
+func (s *FileStore) Deliveries(_ context.Context, since time.Time) ([]*Delivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var deliveries []*Delivery
+	_, err := s.scan(func(d *Delivery) {
+		if !d.ReceivedAt.Before(since) {
+			deliveries = append(deliveries, d)
+		}
+	})
+
+	return deliveries, err
+}
+
+// scan calls fn for each delivery in the store file. Lines have no length
+// limit. A final line without a newline is the remains of an interrupted
+// write and is skipped. scan returns the size of the complete lines read.
+func (s *FileStore) scan(fn func(*Delivery)) (int64, error) {
+	f, err := os.Open(s.path) //nolint:gosec // path is cleaned in OpenFileStore and chosen by the operator
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close() //nolint: errcheck
+
+	var size int64
+	r := bufio.NewReader(f)
+	for line := 1; ; line++ {
+		data, err := r.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			return size, nil
+		}
+		if err != nil {
+			return size, err
+		}
+		size += int64(len(data))
+
+		data = bytes.TrimSpace(data)
+		if len(data) == 0 {
+			continue
+		}
+
+		d := &Delivery{}
+		if err := json.Unmarshal(data, d); err != nil {
+			return size, fmt.Errorf("webhooks: %s:%d: %w", s.path, line, err)
+		}
+		if d.Event != nil {
+			fn(d)
+		}
+	}
+}
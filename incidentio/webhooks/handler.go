@@ -0,0 +1,213 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+)
+
+// DefaultMaxBodyBytes is the largest delivery body accepted by a Handler.
+const DefaultMaxBodyBytes = 1 << 20
+
+// Event represents a verified webhook delivery.
+type Event struct {
+	// ID is the unique delivery ID from the webhook-id header. It stays the
+	// same when Incident.io retries a delivery.
+	ID string `json:"id"`
+	// Type is the event type, e.g. "public_incident.incident_created_v2".
+	Type string `json:"type"`
+	// Timestamp is the time the delivery was signed.
+	Timestamp time.Time `json:"timestamp"`
+	// Payload is the raw JSON body of the delivery.
+	Payload json.RawMessage `json:"payload"`
+}
+
+// Dispatcher handles verified webhook events.
+type Dispatcher interface {
+	Dispatch(ctx context.Context, event *Event) error
+}
+
+// DispatcherFunc adapts an ordinary function to the Dispatcher interface.
+type DispatcherFunc func(ctx context.Context, event *Event) error
+
+// Dispatch calls f(ctx, event).
+func (f DispatcherFunc) Dispatch(ctx context.Context, event *Event) error {
+	return f(ctx, event)
+}
+
+// Handler is an http.Handler that verifies, decodes and dispatches webhook
+// deliveries. Deliveries that fail verification are rejected with 401, and a
+// dispatch error results in a 500 so that Incident.io retries the delivery.
+type Handler struct {
+	verifier     *Verifier
+	dispatcher   Dispatcher
+	maxBodyBytes int64
+	store        DeliveryStore
+	window       time.Duration
+}
+
+// HandlerOption allows for functional options to configure the handler.
+type HandlerOption func(*Handler)
+
+// WithTolerance sets the maximum accepted age of a delivery.
+func WithTolerance(d time.Duration) HandlerOption {
+	return func(h *Handler) {
+		h.verifier.Tolerance = d
+	}
+}
+
+// WithMaxBodyBytes sets the largest delivery body the handler will read.
+func WithMaxBodyBytes(n int64) HandlerOption {
+	return func(h *Handler) {
+		h.maxBodyBytes = n
+	}
+}
+
+// WithDeliveryStore makes the handler acknowledge, without dispatching, any
+// delivery whose ID was already recorded in store within window. A delivery
+// is claimed before it is dispatched, so a redelivery arriving while the
+// first attempt is still running is rejected with 409 instead of being
+// dispatched twice. Deliveries are recorded once they have been dispatched
+// successfully.
+func WithDeliveryStore(store DeliveryStore, window time.Duration) HandlerOption {
+	return func(h *Handler) {
+		h.store = store
+		h.window = window
+	}
+}
+
+// NewHandler returns a Handler that verifies deliveries against secrets and
+// passes them to dispatcher. Pass both the old and the new secret while
+// rotating.
+func NewHandler(secrets []string, dispatcher Dispatcher, opts ...HandlerOption) (*Handler, error) {
+	if dispatcher == nil {
+		return nil, errors.New("webhooks: dispatcher is required")
+	}
+
+	verifier, err := NewVerifier(secrets...)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &Handler{
+		verifier:     verifier,
+		dispatcher:   dispatcher,
+		maxBodyBytes: DefaultMaxBodyBytes,
+	}
+
+	// Apply options
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h, nil
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, h.maxBodyBytes))
+	if err != nil {
+		if errors.As(err, new(*http.MaxBytesError)) {
+			http.Error(w, "body too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, "unable to read body", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.verifier.Verify(r.Header, body); err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	event, err := decodeEvent(r.Header, body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if h.store != nil {
+		claimed, err := h.store.Claim(r.Context(), event.ID, h.verifier.now().Add(-h.window))
+		if err != nil {
+			http.Error(w, "unable to check delivery", http.StatusInternalServerError)
+			return
+		}
+		if !claimed {
+			h.duplicate(w, r, event)
+			return
+		}
+	}
+
+	if err := h.dispatcher.Dispatch(r.Context(), event); err != nil {
+		if h.store != nil {
+			// The request may have been canceled, but the claim must still go
+			// so that the retry is dispatched.
+			_ = h.store.Release(context.WithoutCancel(r.Context()), event.ID)
+		}
+		http.Error(w, "unable to process webhook", http.StatusInternalServerError)
+		return
+	}
+
+	if h.store != nil {
+		// The event has been handled, so failing the request here would only
+		// cause the redelivery we are trying to avoid. A failed record means
+		// the next duplicate is dispatched again.
+		ctx := context.WithoutCancel(r.Context())
+		if err := h.store.Record(ctx, &Delivery{Event: event, ReceivedAt: h.verifier.now()}); err != nil {
+			_ = h.store.Release(ctx, event.ID)
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// duplicate answers a delivery that could not be claimed. A delivery that was
+// already processed is acknowledged. One still being processed by another
+// request is answered with 409, so that Incident.io retries it later rather
+// than it being lost if the first attempt fails.
+func (h *Handler) duplicate(w http.ResponseWriter, r *http.Request, event *Event) {
+	seen, err := h.store.Seen(r.Context(), event.ID, h.verifier.now().Add(-h.window))
+	if err != nil {
+		http.Error(w, "unable to check delivery", http.StatusInternalServerError)
+		return
+	}
+	if !seen {
+		http.Error(w, "delivery is already being processed", http.StatusConflict)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func decodeEvent(header http.Header, body []byte) (*Event, error) {
+	var envelope struct {
+		EventType string `json:"event_type"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return nil, errors.New("webhooks: invalid payload")
+	}
+	if envelope.EventType == "" {
+		return nil, errors.New("webhooks: payload has no event_type")
+	}
+
+	timestamp, err := parseTimestamp(header.Get(HeaderTimestamp))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Event{
+		ID:        header.Get(HeaderID),
+		Type:      envelope.EventType,
+		Timestamp: timestamp,
+		Payload:   body,
+	}, nil
+}
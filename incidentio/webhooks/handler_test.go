@@ -0,0 +1,253 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"testing/iotest"
+	"time"
+)
+
+func newSignedRequest(t *testing.T, id string, body []byte) *http.Request {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks", bytes.NewReader(body))
+	for k, v := range signedHeader(t, testSecret, id, time.Now(), body) {
+		req.Header[k] = v
+	}
+
+	return req
+}
+
+func TestHandler_ServeHTTP(t *testing.T) {
+	body := []byte(`{"event_type":"public_incident.incident_created_v2","public_incident.incident_created_v2":{"id":"01FDAG4SAP5TYPT98WGR2N7W91"}}`)
+
+	var got *Event
+	h, err := NewHandler([]string{testSecret}, DispatcherFunc(func(_ context.Context, event *Event) error {
+		got = event
+		return nil
+	}))
+	if err != nil {
+		t.Fatalf("NewHandler() error = %v", err)
+	}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, newSignedRequest(t, "msg_1", body))
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("ServeHTTP() status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	if got == nil {
+		t.Fatal("ServeHTTP() did not dispatch the event")
+	}
+
+	if got.ID != "msg_1" {
+		t.Errorf("Event.ID = %q, want %q", got.ID, "msg_1")
+	}
+
+	if got.Type != "public_incident.incident_created_v2" {
+		t.Errorf("Event.Type = %q, want %q", got.Type, "public_incident.incident_created_v2")
+	}
+
+	if !bytes.Equal(got.Payload, body) {
+		t.Errorf("Event.Payload = %s, want %s", got.Payload, body)
+	}
+}
+
+func TestHandler_ServeHTTP_Rejections(t *testing.T) {
+	body := []byte(`{"event_type":"public_incident.incident_created_v2"}`)
+
+	tests := []struct {
+		name     string
+		request  func() *http.Request
+		dispatch error
+		want     int
+	}{
+		{
+			name: "wrong method",
+			request: func() *http.Request {
+				return httptest.NewRequest(http.MethodGet, "/webhooks", nil)
+			},
+			want: http.StatusMethodNotAllowed,
+		},
+		{
+			name: "unsigned delivery",
+			request: func() *http.Request {
+				return httptest.NewRequest(http.MethodPost, "/webhooks", bytes.NewReader(body))
+			},
+			want: http.StatusUnauthorized,
+		},
+		{
+			name: "tampered body",
+			request: func() *http.Request {
+				req := newSignedRequest(t, "msg_1", body)
+				req.Body = http.NoBody
+				return req
+			},
+			want: http.StatusUnauthorized,
+		},
+		{
+			name: "oversized body",
+			request: func() *http.Request {
+				return newSignedRequest(t, "msg_1", bytes.Repeat([]byte("x"), DefaultMaxBodyBytes+1))
+			},
+			want: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name: "unreadable body",
+			request: func() *http.Request {
+				req := newSignedRequest(t, "msg_1", body)
+				req.Body = io.NopCloser(iotest.ErrReader(errors.New("connection reset")))
+				return req
+			},
+			want: http.StatusBadRequest,
+		},
+		{
+			name: "payload without event type",
+			request: func() *http.Request {
+				return newSignedRequest(t, "msg_1", []byte(`{}`))
+			},
+			want: http.StatusBadRequest,
+		},
+		{
+			name: "dispatch error",
+			request: func() *http.Request {
+				return newSignedRequest(t, "msg_1", body)
+			},
+			dispatch: errors.New("boom"),
+			want:     http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, err := NewHandler([]string{testSecret}, DispatcherFunc(func(context.Context, *Event) error {
+				return tt.dispatch
+			}))
+			if err != nil {
+				t.Fatalf("NewHandler() error = %v", err)
+			}
+
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, tt.request())
+
+			if rec.Code != tt.want {
+				t.Errorf("ServeHTTP() status = %d, want %d", rec.Code, tt.want)
+			}
+		})
+	}
+}
+
+func TestHandler_ServeHTTP_Deduplication(t *testing.T) {
+	body := []byte(`{"event_type":"public_incident.incident_created_v2"}`)
+
+	calls := 0
+	store := NewMemoryStore(10)
+	h, err := NewHandler([]string{testSecret}, DispatcherFunc(func(context.Context, *Event) error {
+		calls++
+		return nil
+	}), WithDeliveryStore(store, time.Hour))
+	if err != nil {
+		t.Fatalf("NewHandler() error = %v", err)
+	}
+
+	for _, id := range []string{"msg_1", "msg_1", "msg_2"} {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, newSignedRequest(t, id, body))
+
+		if rec.Code != http.StatusOK {
+			t.Errorf("ServeHTTP(%s) status = %d, want %d", id, rec.Code, http.StatusOK)
+		}
+	}
+
+	if calls != 2 {
+		t.Errorf("dispatched %d deliveries, want 2", calls)
+	}
+}
+
+func TestHandler_ServeHTTP_ConcurrentDuplicate(t *testing.T) {
+	body := []byte(`{"event_type":"public_incident.incident_created_v2"}`)
+
+	var calls int32
+	started := make(chan struct{})
+	finish := make(chan struct{})
+	store := NewMemoryStore(10)
+	h, err := NewHandler([]string{testSecret}, DispatcherFunc(func(context.Context, *Event) error {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			close(started)
+			<-finish
+		}
+		return nil
+	}), WithDeliveryStore(store, time.Hour))
+	if err != nil {
+		t.Fatalf("NewHandler() error = %v", err)
+	}
+
+	first := httptest.NewRecorder()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		h.ServeHTTP(first, newSignedRequest(t, "msg_1", body))
+	}()
+	<-started
+
+	// The retry arrives while the first attempt is still being dispatched.
+	retry := httptest.NewRecorder()
+	h.ServeHTTP(retry, newSignedRequest(t, "msg_1", body))
+	if retry.Code != http.StatusConflict {
+		t.Errorf("ServeHTTP() status for in-flight duplicate = %d, want %d", retry.Code, http.StatusConflict)
+	}
+
+	close(finish)
+	<-done
+	if first.Code != http.StatusOK {
+		t.Errorf("ServeHTTP() status = %d, want %d", first.Code, http.StatusOK)
+	}
+
+	// Once the first attempt is recorded, later retries are acknowledged.
+	later := httptest.NewRecorder()
+	h.ServeHTTP(later, newSignedRequest(t, "msg_1", body))
+	if later.Code != http.StatusOK {
+		t.Errorf("ServeHTTP() status for processed duplicate = %d, want %d", later.Code, http.StatusOK)
+	}
+
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Errorf("dispatched %d times, want 1", n)
+	}
+}
+
+func TestHandler_ServeHTTP_ReleaseOnError(t *testing.T) {
+	body := []byte(`{"event_type":"public_incident.incident_created_v2"}`)
+
+	calls := 0
+	store := NewMemoryStore(10)
+	h, err := NewHandler([]string{testSecret}, DispatcherFunc(func(context.Context, *Event) error {
+		calls++
+		if calls == 1 {
+			return errors.New("downstream unavailable")
+		}
+		return nil
+	}), WithDeliveryStore(store, time.Hour))
+	if err != nil {
+		t.Fatalf("NewHandler() error = %v", err)
+	}
+
+	for _, want := range []int{http.StatusInternalServerError, http.StatusOK, http.StatusOK} {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, newSignedRequest(t, "msg_1", body))
+
+		if rec.Code != want {
+			t.Errorf("ServeHTTP() status = %d, want %d", rec.Code, want)
+		}
+	}
+
+	if calls != 2 {
+		t.Errorf("dispatched %d times, want 2", calls)
+	}
+}
@@ -0,0 +1,109 @@
+package webhooks
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryStore is an in-memory DeliveryStore that keeps the most recently
+// recorded deliveries, evicting the least recently recorded once full.
+type MemoryStore struct {
+	mu       sync.Mutex
+	capacity int
+	order    *list.List
+	index    map[string]*list.Element
+	claimed  map[string]struct{}
+}
+
+// NewMemoryStore returns a MemoryStore holding at most capacity deliveries.
+func NewMemoryStore(capacity int) *MemoryStore {
+	if capacity < 1 {
+		capacity = 1
+	}
+
+	return &MemoryStore{
+		capacity: capacity,
+		order:    list.New(),
+		index:    make(map[string]*list.Element),
+		claimed:  make(map[string]struct{}),
+	}
+}
+
+// Seen implements DeliveryStore.
+func (s *MemoryStore) Seen(_ context.Context, id string, since time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.index[id]
+	if !ok {
+		return false, nil
+	}
+
+	return !e.Value.(*Delivery).ReceivedAt.Before(since), nil
+}
+
+// Claim implements DeliveryStore.
+func (s *MemoryStore) Claim(_ context.Context, id string, since time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.claimed[id]; ok {
+		return false, nil
+	}
+	if e, ok := s.index[id]; ok && !e.Value.(*Delivery).ReceivedAt.Before(since) {
+		return false, nil
+	}
+	s.claimed[id] = struct{}{}
+
+	return true, nil
+}
+
+// Release implements DeliveryStore.
+func (s *MemoryStore) Release(_ context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.claimed, id)
+
+	return nil
+}
+
+// Record implements DeliveryStore.
+func (s *MemoryStore) Record(_ context.Context, delivery *Delivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.claimed, delivery.Event.ID)
+
+	if e, ok := s.index[delivery.Event.ID]; ok {
+		e.Value = delivery
+		s.order.MoveToBack(e)
+		return nil
+	}
+
+	s.index[delivery.Event.ID] = s.order.PushBack(delivery)
+	for s.order.Len() > s.capacity {
+		oldest := s.order.Front()
+		s.order.Remove(oldest)
+		delete(s.index, oldest.Value.(*Delivery).Event.ID)
+	}
+
+	return nil
+}
+
+// Deliveries implements DeliveryStore.
+func (s *MemoryStore) Deliveries(_ context.Context, since time.Time) ([]*Delivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var deliveries []*Delivery
+	for e := s.order.Front(); e != nil; e = e.Next() {
+		if d := e.Value.(*Delivery); !d.ReceivedAt.Before(since) {
+			deliveries = append(deliveries, d)
+		}
+	}
+
+	return deliveries, nil
+}
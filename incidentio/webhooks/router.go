@@ -0,0 +1,152 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+type eventContextKey struct{}
+
+// EventFromContext returns the delivery being dispatched by a Router.
+func EventFromContext(ctx context.Context) (*Event, bool) {
+	event, ok := ctx.Value(eventContextKey{}).(*Event)
+	return event, ok
+}
+
+// Router is a Dispatcher that decodes events into their typed payloads and
+// calls the handler registered for the event type. Events without a handler
+// go to the fallback, or are acknowledged and dropped when none is set.
+type Router struct {
+	handlers map[string]DispatcherFunc
+	fallback DispatcherFunc
+}
+
+// NewRouter returns an empty Router.
+func NewRouter() *Router {
+	return &Router{handlers: make(map[string]DispatcherFunc)}
+}
+
+// Dispatch implements Dispatcher.
+func (r *Router) Dispatch(ctx context.Context, event *Event) error {
+	ctx = context.WithValue(ctx, eventContextKey{}, event)
+
+	if h, ok := r.handlers[event.Type]; ok {
+		return h(ctx, event)
+	}
+	if r.fallback != nil {
+		return r.fallback(ctx, event)
+	}
+
+	return nil
+}
+
+// Handle registers a handler receiving the raw event for eventType.
+func (r *Router) Handle(eventType string, fn DispatcherFunc) {
+	r.handlers[eventType] = fn
+}
+
+// OnUnknown registers the handler called for events with no registered handler.
+func (r *Router) OnUnknown(fn DispatcherFunc) {
+	r.fallback = fn
+}
+
+// OnIncidentCreated registers the handler for public incident creation.
+func (r *Router) OnIncidentCreated(fn func(context.Context, *IncidentCreatedEvent) error) {
+	on(r, EventTypeIncidentCreated, fn)
+}
+
+// OnIncidentUpdated registers the handler for public incident updates.
+func (r *Router) OnIncidentUpdated(fn func(context.Context, *IncidentUpdatedEvent) error) {
+	on(r, EventTypeIncidentUpdated, fn)
+}
+
+// OnIncidentStatusUpdated registers the handler for public incident status changes.
+func (r *Router) OnIncidentStatusUpdated(fn func(context.Context, *IncidentStatusUpdatedEvent) error) {
+	on(r, EventTypeIncidentStatusUpdated, fn)
+}
+
+// OnFollowUpCreated registers the handler for follow-ups created on public incidents.
+func (r *Router) OnFollowUpCreated(fn func(context.Context, *FollowUpCreatedEvent) error) {
+	on(r, EventTypeFollowUpCreated, fn)
+}
+
+// OnFollowUpUpdated registers the handler for follow-ups updated on public incidents.
+func (r *Router) OnFollowUpUpdated(fn func(context.Context, *FollowUpUpdatedEvent) error) {
+	on(r, EventTypeFollowUpUpdated, fn)
+}
+
+// OnActionCreated registers the handler for actions created on public incidents.
+func (r *Router) OnActionCreated(fn func(context.Context, *ActionCreatedEvent) error) {
+	on(r, EventTypeActionCreated, fn)
+}
+
+// OnActionUpdated registers the handler for actions updated on public incidents.
+func (r *Router) OnActionUpdated(fn func(context.Context, *ActionUpdatedEvent) error) {
+	on(r, EventTypeActionUpdated, fn)
+}
+
+// OnPrivateIncidentCreated registers the handler for private incident creation.
+func (r *Router) OnPrivateIncidentCreated(fn func(context.Context, *PrivateIncidentEvent) error) {
+	on(r, EventTypePrivateIncidentCreated, fn)
+}
+
+// OnPrivateIncidentUpdated registers the handler for private incident updates.
+func (r *Router) OnPrivateIncidentUpdated(fn func(context.Context, *PrivateIncidentEvent) error) {
+	on(r, EventTypePrivateIncidentUpdated, fn)
+}
+
+// OnPrivateIncidentStatusUpdated registers the handler for private incident status changes.
+func (r *Router) OnPrivateIncidentStatusUpdated(fn func(context.Context, *PrivateIncidentEvent) error) {
+	on(r, EventTypePrivateIncidentStatusUpdated, fn)
+}
+
+// OnPrivateFollowUpCreated registers the handler for follow-ups created on private incidents.
+func (r *Router) OnPrivateFollowUpCreated(fn func(context.Context, *PrivateFollowUpEvent) error) {
+	on(r, EventTypePrivateFollowUpCreated, fn)
+}
+
+// OnPrivateFollowUpUpdated registers the handler for follow-ups updated on private incidents.
+func (r *Router) OnPrivateFollowUpUpdated(fn func(context.Context, *PrivateFollowUpEvent) error) {
+	on(r, EventTypePrivateFollowUpUpdated, fn)
+}
+
+// OnPrivateActionCreated registers the handler for actions created on private incidents.
+func (r *Router) OnPrivateActionCreated(fn func(context.Context, *PrivateActionEvent) error) {
+	on(r, EventTypePrivateActionCreated, fn)
+}
+
+// OnPrivateActionUpdated registers the handler for actions updated on private incidents.
+func (r *Router) OnPrivateActionUpdated(fn func(context.Context, *PrivateActionEvent) error) {
+	on(r, EventTypePrivateActionUpdated, fn)
+}
+
+// on registers fn for eventType, decoding the payload into T first.
+func on[T any](r *Router, eventType string, fn func(context.Context, *T) error) {
+	r.Handle(eventType, func(ctx context.Context, event *Event) error {
+		payload := new(T)
+		if err := decodePayload(event, payload); err != nil {
+			return err
+		}
+		return fn(ctx, payload)
+	})
+}
+
+// decodePayload decodes the object keyed by the event type in the delivery body.
+func decodePayload(event *Event, v interface{}) error {
+	var body map[string]json.RawMessage
+	if err := json.Unmarshal(event.Payload, &body); err != nil {
+		return fmt.Errorf("webhooks: decoding %s: %w", event.Type, err)
+	}
+
+	raw, ok := body[event.Type]
+	if !ok {
+		return fmt.Errorf("webhooks: %s payload is missing", event.Type)
+	}
+
+	if err := json.Unmarshal(raw, v); err != nil {
+		return fmt.Errorf("webhooks: decoding %s: %w", event.Type, err)
+	}
+
+	return nil
+}
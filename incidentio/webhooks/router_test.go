@@ -0,0 +1,171 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cpanato/go-incident-io/incidentio"
+)
+
+func TestRouter_Dispatch(t *testing.T) {
+	router := NewRouter()
+
+	var updated *IncidentUpdatedEvent
+	router.OnIncidentUpdated(func(ctx context.Context, event *IncidentUpdatedEvent) error {
+		if delivery, ok := EventFromContext(ctx); !ok || delivery.ID != "msg_1" {
+			t.Errorf("EventFromContext() = %+v, %v, want delivery msg_1", delivery, ok)
+		}
+		updated = event
+		return nil
+	})
+
+	var action *ActionUpdatedEvent
+	router.OnActionUpdated(func(_ context.Context, event *ActionUpdatedEvent) error {
+		action = event
+		return nil
+	})
+
+	var statusUpdated *IncidentStatusUpdatedEvent
+	router.OnIncidentStatusUpdated(func(_ context.Context, event *IncidentStatusUpdatedEvent) error {
+		statusUpdated = event
+		return nil
+	})
+
+	var private *PrivateIncidentEvent
+	router.OnPrivateIncidentCreated(func(_ context.Context, event *PrivateIncidentEvent) error {
+		private = event
+		return nil
+	})
+
+	ctx := context.Background()
+
+	err := router.Dispatch(ctx, &Event{
+		ID:   "msg_1",
+		Type: EventTypeIncidentUpdated,
+		Payload: []byte(`{
+			"event_type": "public_incident.incident_updated_v2",
+			"public_incident.incident_updated_v2": {
+				"id": "01FDAG4SAP5TYPT98WGR2N7W91",
+				"name": "Database Connection Issues",
+				"status": "live",
+				"created_at": "2021-08-17T13:28:57.801578Z",
+				"updated_at": "2021-08-17T13:28:57.801578Z"
+			}
+		}`),
+	})
+	if err != nil {
+		t.Errorf("Dispatch() error = %v", err)
+	}
+
+	if updated == nil || updated.Incident == nil || updated.Incident.Status != "live" {
+		t.Errorf("OnIncidentUpdated received %+v, want live incident", updated)
+	}
+
+	err = router.Dispatch(ctx, &Event{
+		ID:   "msg_2",
+		Type: EventTypeActionUpdated,
+		Payload: []byte(`{
+			"event_type": "public_incident.action_updated_v1",
+			"public_incident.action_updated_v1": {
+				"id": "01FCNDV6P870EA6S7TK1DSYDG0",
+				"incident_id": "01FDAG4SAP5TYPT98WGR2N7W91",
+				"status": "completed",
+				"created_at": "2021-08-17T13:28:57.801578Z",
+				"updated_at": "2021-08-17T13:28:57.801578Z"
+			}
+		}`),
+	})
+	if err != nil {
+		t.Errorf("Dispatch() error = %v", err)
+	}
+
+	if action == nil || action.Action == nil || action.Action.Status != "completed" {
+		t.Errorf("OnActionUpdated received %+v, want completed action", action)
+	}
+
+	err = router.Dispatch(ctx, &Event{
+		ID:   "msg_3",
+		Type: EventTypeIncidentStatusUpdated,
+		Payload: []byte(`{
+			"event_type": "public_incident.incident_status_updated_v2",
+			"public_incident.incident_status_updated_v2": {
+				"incident": {
+					"id": "01FDAG4SAP5TYPT98WGR2N7W91",
+					"name": "Database Connection Issues",
+					"status": "live",
+					"created_at": "2021-08-17T13:28:57.801578Z",
+					"updated_at": "2021-08-17T13:28:57.801578Z"
+				},
+				"new_status": {"id": "01FCNDV6P870EA6S7TK1DSYDG1", "name": "Investigating", "category": "live"},
+				"previous_status": {"id": "01FCNDV6P870EA6S7TK1DSYDG2", "name": "Triage", "category": "triage"}
+			}
+		}`),
+	})
+	if err != nil {
+		t.Errorf("Dispatch() error = %v", err)
+	}
+
+	if statusUpdated == nil || statusUpdated.Incident == nil || statusUpdated.NewStatus == nil || statusUpdated.PreviousStatus == nil {
+		t.Fatalf("OnIncidentStatusUpdated received %+v, want incident and both statuses", statusUpdated)
+	}
+	if statusUpdated.NewStatus.Name != "Investigating" || statusUpdated.NewStatus.Category != incidentio.IncidentStatusCategoryLive {
+		t.Errorf("NewStatus = %+v, want Investigating (live)", statusUpdated.NewStatus)
+	}
+	if statusUpdated.PreviousStatus.Category != incidentio.IncidentStatusCategoryTriage {
+		t.Errorf("PreviousStatus = %+v, want triage", statusUpdated.PreviousStatus)
+	}
+
+	err = router.Dispatch(ctx, &Event{
+		ID:      "msg_4",
+		Type:    EventTypePrivateIncidentCreated,
+		Payload: []byte(`{"event_type": "private_incident.incident_created_v2", "private_incident.incident_created_v2": {"id": "01FDAG4SAP5TYPT98WGR2N7W92"}}`),
+	})
+	if err != nil {
+		t.Errorf("Dispatch() error = %v", err)
+	}
+
+	if private == nil || private.ID != "01FDAG4SAP5TYPT98WGR2N7W92" {
+		t.Errorf("OnPrivateIncidentCreated received %+v, want ID 01FDAG4SAP5TYPT98WGR2N7W92", private)
+	}
+}
+
+func TestRouter_Dispatch_Unknown(t *testing.T) {
+	router := NewRouter()
+
+	if err := router.Dispatch(context.Background(), &Event{Type: "public_incident.something_new_v1"}); err != nil {
+		t.Errorf("Dispatch() without fallback error = %v, want nil", err)
+	}
+
+	wantErr := errors.New("unhandled")
+	var got string
+	router.OnUnknown(func(_ context.Context, event *Event) error {
+		got = event.Type
+		return wantErr
+	})
+
+	err := router.Dispatch(context.Background(), &Event{Type: "public_incident.something_new_v1"})
+	if !errors.Is(err, wantErr) {
+		t.Errorf("Dispatch() error = %v, want %v", err, wantErr)
+	}
+
+	if got != "public_incident.something_new_v1" {
+		t.Errorf("OnUnknown received %q, want %q", got, "public_incident.something_new_v1")
+	}
+}
+
+func TestRouter_Dispatch_MissingPayload(t *testing.T) {
+	router := NewRouter()
+	router.OnIncidentCreated(func(context.Context, *IncidentCreatedEvent) error {
+		t.Error("handler called for a delivery without payload")
+		return nil
+	})
+
+	err := router.Dispatch(context.Background(), &Event{
+		Type:    EventTypeIncidentCreated,
+		Payload: []byte(`{"event_type": "public_incident.incident_created_v2"}`),
+	})
+	if err == nil {
+		t.Error("Dispatch() error = nil, want error")
+	}
+}
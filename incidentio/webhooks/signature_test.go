@@ -0,0 +1,155 @@
+package webhooks
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+const (
+	testSecret    = "whsec_MfKQ9r8GKYqrTwjUPD8ILPZIo2LaLaSw"
+	testOldSecret = "whsec_dGhlLW9sZC1zZWNyZXQtdmFsdWU="
+)
+
+func signedHeader(t *testing.T, secret, id string, timestamp time.Time, body []byte) http.Header {
+	t.Helper()
+
+	signature, err := Sign(secret, id, timestamp, body)
+	if err != nil {
+		t.Fatalf("Sign() error = %v", err)
+	}
+
+	header := http.Header{}
+	header.Set(HeaderID, id)
+	header.Set(HeaderTimestamp, strconv.FormatInt(timestamp.Unix(), 10))
+	header.Set(HeaderSignature, signature)
+
+	return header
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	body := []byte(`{"event_type":"public_incident.incident_created_v2"}`)
+
+	tests := []struct {
+		name    string
+		secrets []string
+		header  func() http.Header
+		wantErr error
+	}{
+		{
+			name:    "valid signature",
+			secrets: []string{testSecret},
+			header: func() http.Header {
+				return signedHeader(t, testSecret, "msg_1", now, body)
+			},
+		},
+		{
+			name:    "signed with rotated secret",
+			secrets: []string{testSecret, testOldSecret},
+			header: func() http.Header {
+				return signedHeader(t, testOldSecret, "msg_1", now, body)
+			},
+		},
+		{
+			name:    "one of several signatures matches",
+			secrets: []string{testSecret},
+			header: func() http.Header {
+				h := signedHeader(t, testSecret, "msg_1", now, body)
+				h.Set(HeaderSignature, "v1,bm90LWEtc2lnbmF0dXJl "+h.Get(HeaderSignature))
+				return h
+			},
+		},
+		{
+			name:    "wrong secret",
+			secrets: []string{testSecret},
+			header: func() http.Header {
+				return signedHeader(t, testOldSecret, "msg_1", now, body)
+			},
+			wantErr: ErrInvalidSignature,
+		},
+		{
+			name:    "tampered id",
+			secrets: []string{testSecret},
+			header: func() http.Header {
+				h := signedHeader(t, testSecret, "msg_1", now, body)
+				h.Set(HeaderID, "msg_2")
+				return h
+			},
+			wantErr: ErrInvalidSignature,
+		},
+		{
+			name:    "stale timestamp",
+			secrets: []string{testSecret},
+			header: func() http.Header {
+				return signedHeader(t, testSecret, "msg_1", now.Add(-10*time.Minute), body)
+			},
+			wantErr: ErrStaleTimestamp,
+		},
+		{
+			name:    "timestamp in the future",
+			secrets: []string{testSecret},
+			header: func() http.Header {
+				return signedHeader(t, testSecret, "msg_1", now.Add(10*time.Minute), body)
+			},
+			wantErr: ErrStaleTimestamp,
+		},
+		{
+			name:    "invalid timestamp",
+			secrets: []string{testSecret},
+			header: func() http.Header {
+				h := signedHeader(t, testSecret, "msg_1", now, body)
+				h.Set(HeaderTimestamp, "yesterday")
+				return h
+			},
+			wantErr: ErrInvalidTimestamp,
+		},
+		{
+			name:    "missing headers",
+			secrets: []string{testSecret},
+			header: func() http.Header {
+				return http.Header{}
+			},
+			wantErr: ErrMissingHeaders,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := NewVerifier(tt.secrets...)
+			if err != nil {
+				t.Fatalf("NewVerifier() error = %v", err)
+			}
+			v.now = func() time.Time { return now }
+
+			err = v.Verify(tt.header(), body)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Verify() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewVerifier_InvalidSecret(t *testing.T) {
+	if _, err := NewVerifier(); err == nil {
+		t.Error("NewVerifier() with no secrets returned nil error")
+	}
+
+	if _, err := NewVerifier("whsec_not base64!"); err == nil {
+		t.Error("NewVerifier() with invalid secret returned nil error")
+	}
+
+	for _, secret := range []string{"", "whsec_"} {
+		if _, err := NewVerifier(secret); err == nil {
+			t.Errorf("NewVerifier(%q) returned nil error, want an empty secret rejected", secret)
+		}
+		if _, err := NewVerifier(testSecret, secret); err == nil {
+			t.Errorf("NewVerifier(testSecret, %q) returned nil error, want an empty secret rejected", secret)
+		}
+		if _, err := Sign(secret, "msg_1", time.Now(), nil); err == nil {
+			t.Errorf("Sign(%q) returned nil error, want an empty secret rejected", secret)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package webhooks
+
+import (
+	"context"
+	"time"
+)
+
+// Delivery is a webhook delivery recorded by a DeliveryStore.
+type Delivery struct {
+	Event      *Event    `json:"event"`
+	ReceivedAt time.Time `json:"received_at"`
+}
+
+// DeliveryStore records processed deliveries so that redelivered webhooks
+// can be dropped and processed ones replayed.
+type DeliveryStore interface {
+	// Seen reports whether a delivery with the given ID was recorded at or
+	// after since.
+	Seen(ctx context.Context, id string, since time.Time) (bool, error)
+	// Claim reserves the delivery with the given ID for processing. It
+	// returns false if the delivery was recorded at or after since, or is
+	// already claimed. The claim lasts until Record or Release is called.
+	Claim(ctx context.Context, id string, since time.Time) (bool, error)
+	// Release drops the claim on a delivery that could not be processed, so
+	// that a redelivery is processed again.
+	Release(ctx context.Context, id string) error
+	// Record stores a processed delivery, ending its claim.
+	Record(ctx context.Context, delivery *Delivery) error
+	// Deliveries returns the deliveries recorded at or after since, oldest first.
+	Deliveries(ctx context.Context, since time.Time) ([]*Delivery, error)
+}
+
+// Replay dispatches the deliveries recorded in store at or after since to
+// dispatcher, oldest first. It stops at the first dispatch error.
+func Replay(ctx context.Context, store DeliveryStore, dispatcher Dispatcher, since time.Time) error {
+	deliveries, err := store.Deliveries(ctx, since)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range deliveries {
+		if err := dispatcher.Dispatch(ctx, d.Event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,305 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testDelivery(id string, receivedAt time.Time) *Delivery {
+	return &Delivery{
+		Event: &Event{
+			ID:        id,
+			Type:      EventTypeIncidentCreated,
+			Timestamp: receivedAt.UTC(),
+			Payload:   []byte(`{"event_type":"public_incident.incident_created_v2"}`),
+		},
+		ReceivedAt: receivedAt.UTC(),
+	}
+}
+
+func TestDeliveryStores(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	stores := map[string]func(t *testing.T) DeliveryStore{
+		"memory": func(*testing.T) DeliveryStore {
+			return NewMemoryStore(10)
+		},
+		"file": func(t *testing.T) DeliveryStore {
+			s, err := OpenFileStore(filepath.Join(t.TempDir(), "deliveries.jsonl"))
+			if err != nil {
+				t.Fatalf("OpenFileStore() error = %v", err)
+			}
+			t.Cleanup(func() { _ = s.Close() })
+			return s
+		},
+	}
+
+	for name, newStore := range stores {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			s := newStore(t)
+
+			for _, d := range []*Delivery{
+				testDelivery("msg_1", now.Add(-2*time.Hour)),
+				testDelivery("msg_2", now.Add(-time.Minute)),
+			} {
+				if err := s.Record(ctx, d); err != nil {
+					t.Fatalf("Record() error = %v", err)
+				}
+			}
+
+			tests := []struct {
+				id    string
+				since time.Time
+				want  bool
+			}{
+				{id: "msg_1", since: now.Add(-3 * time.Hour), want: true},
+				{id: "msg_1", since: now.Add(-time.Hour), want: false},
+				{id: "msg_2", since: now.Add(-time.Hour), want: true},
+				{id: "msg_3", since: time.Time{}, want: false},
+			}
+			for _, tt := range tests {
+				seen, err := s.Seen(ctx, tt.id, tt.since)
+				if err != nil {
+					t.Errorf("Seen(%q) error = %v", tt.id, err)
+				}
+				if seen != tt.want {
+					t.Errorf("Seen(%q, %v) = %v, want %v", tt.id, tt.since, seen, tt.want)
+				}
+			}
+
+			if claimed, _ := s.Claim(ctx, "msg_2", now.Add(-time.Hour)); claimed {
+				t.Error("Claim(msg_2) = true for a recorded delivery, want false")
+			}
+			if claimed, _ := s.Claim(ctx, "msg_1", now.Add(-time.Hour)); !claimed {
+				t.Error("Claim(msg_1) = false for a delivery outside the window, want true")
+			}
+			if claimed, _ := s.Claim(ctx, "msg_3", now.Add(-time.Hour)); !claimed {
+				t.Error("Claim(msg_3) = false for a new delivery, want true")
+			}
+			if claimed, _ := s.Claim(ctx, "msg_3", now.Add(-time.Hour)); claimed {
+				t.Error("Claim(msg_3) = true while already claimed, want false")
+			}
+			if err := s.Release(ctx, "msg_3"); err != nil {
+				t.Errorf("Release() error = %v", err)
+			}
+			if claimed, _ := s.Claim(ctx, "msg_3", now.Add(-time.Hour)); !claimed {
+				t.Error("Claim(msg_3) = false after release, want true")
+			}
+
+			deliveries, err := s.Deliveries(ctx, now.Add(-time.Hour))
+			if err != nil {
+				t.Errorf("Deliveries() error = %v", err)
+			}
+			if len(deliveries) != 1 || !reflect.DeepEqual(deliveries[0], testDelivery("msg_2", now.Add(-time.Minute))) {
+				t.Errorf("Deliveries() = %+v, want msg_2 only", deliveries)
+			}
+		})
+	}
+}
+
+func TestMemoryStore_Eviction(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1700000000, 0)
+	s := NewMemoryStore(2)
+
+	for _, id := range []string{"msg_1", "msg_2", "msg_3"} {
+		_ = s.Record(ctx, testDelivery(id, now))
+	}
+
+	if seen, _ := s.Seen(ctx, "msg_1", time.Time{}); seen {
+		t.Error("Seen(msg_1) = true after eviction, want false")
+	}
+
+	if seen, _ := s.Seen(ctx, "msg_3", time.Time{}); !seen {
+		t.Error("Seen(msg_3) = false, want true")
+	}
+}
+
+func TestFileStore_Reopen(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1700000000, 0)
+	path := filepath.Join(t.TempDir(), "deliveries.jsonl")
+
+	s, err := OpenFileStore(path)
+	if err != nil {
+		t.Fatalf("OpenFileStore() error = %v", err)
+	}
+	_ = s.Record(ctx, testDelivery("msg_1", now))
+	_ = s.Close()
+
+	s, err = OpenFileStore(path)
+	if err != nil {
+		t.Fatalf("OpenFileStore() error = %v", err)
+	}
+	defer s.Close() //nolint: errcheck
+
+	if seen, _ := s.Seen(ctx, "msg_1", now.Add(-time.Minute)); !seen {
+		t.Error("Seen(msg_1) = false after reopening, want true")
+	}
+}
+
+func TestFileStore_LargeDelivery(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1700000000, 0)
+	path := filepath.Join(t.TempDir(), "deliveries.jsonl")
+
+	s, err := OpenFileStore(path)
+	if err != nil {
+		t.Fatalf("OpenFileStore() error = %v", err)
+	}
+
+	large := testDelivery("msg_large", now)
+	large.Event.Payload = []byte(`{"event_type":"public_incident.incident_created_v2","padding":"` + strings.Repeat("x", 3*DefaultMaxBodyBytes) + `"}`)
+	if err := s.Record(ctx, large); err != nil {
+		t.Fatalf("Record() error = %v", err)
+	}
+	_ = s.Record(ctx, testDelivery("msg_after", now))
+	_ = s.Close()
+
+	s, err = OpenFileStore(path)
+	if err != nil {
+		t.Fatalf("OpenFileStore() after a large delivery error = %v", err)
+	}
+	defer s.Close() //nolint: errcheck
+
+	deliveries, err := s.Deliveries(ctx, time.Time{})
+	if err != nil {
+		t.Fatalf("Deliveries() error = %v", err)
+	}
+	if len(deliveries) != 2 || !reflect.DeepEqual(deliveries[0], large) {
+		t.Errorf("Deliveries() returned %d deliveries, want the large delivery and msg_after", len(deliveries))
+	}
+}
+
+func TestFileStore_TornLine(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1700000000, 0)
+	path := filepath.Join(t.TempDir(), "deliveries.jsonl")
+
+	s, err := OpenFileStore(path)
+	if err != nil {
+		t.Fatalf("OpenFileStore() error = %v", err)
+	}
+	_ = s.Record(ctx, testDelivery("msg_1", now))
+	_ = s.Close()
+
+	// Simulate a crash in the middle of writing the next record.
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = f.WriteString(`{"event":{"id":"msg_2","type":"public_inc`)
+	_ = f.Close()
+
+	s, err = OpenFileStore(path)
+	if err != nil {
+		t.Fatalf("OpenFileStore() with a torn last line error = %v", err)
+	}
+	if err := s.Record(ctx, testDelivery("msg_3", now)); err != nil {
+		t.Fatalf("Record() error = %v", err)
+	}
+	_ = s.Close()
+
+	s, err = OpenFileStore(path)
+	if err != nil {
+		t.Fatalf("OpenFileStore() after recovery error = %v", err)
+	}
+	defer s.Close() //nolint: errcheck
+
+	deliveries, err := s.Deliveries(ctx, time.Time{})
+	if err != nil {
+		t.Fatalf("Deliveries() error = %v", err)
+	}
+	var got []string
+	for _, d := range deliveries {
+		got = append(got, d.Event.ID)
+	}
+	if want := []string{"msg_1", "msg_3"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Deliveries() = %v, want %v", got, want)
+	}
+}
+
+// failingFile writes only the first n bytes of each write, then fails, as a
+// full disk would.
+type failingFile struct {
+	storeFile
+	n int
+}
+
+func (f *failingFile) Write(p []byte) (int, error) {
+	written, _ := f.storeFile.Write(p[:f.n])
+	return written, errors.New("no space left on device")
+}
+
+func TestFileStore_FailedWrite(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1700000000, 0)
+	path := filepath.Join(t.TempDir(), "deliveries.jsonl")
+
+	s, err := OpenFileStore(path)
+	if err != nil {
+		t.Fatalf("OpenFileStore() error = %v", err)
+	}
+	_ = s.Record(ctx, testDelivery("msg_1", now))
+
+	file := s.file
+	s.file = &failingFile{storeFile: file, n: 20}
+	if err := s.Record(ctx, testDelivery("msg_2", now)); err == nil {
+		t.Fatal("Record() error = nil for a failed write, want error")
+	}
+	if seen, _ := s.Seen(ctx, "msg_2", time.Time{}); seen {
+		t.Error("Seen(msg_2) = true after a failed write, want false")
+	}
+
+	s.file = file
+	if err := s.Record(ctx, testDelivery("msg_3", now)); err != nil {
+		t.Fatalf("Record() after a failed write error = %v", err)
+	}
+	_ = s.Close()
+
+	s, err = OpenFileStore(path)
+	if err != nil {
+		t.Fatalf("OpenFileStore() after a failed write error = %v", err)
+	}
+	defer s.Close() //nolint: errcheck
+
+	deliveries, err := s.Deliveries(ctx, time.Time{})
+	if err != nil {
+		t.Fatalf("Deliveries() error = %v", err)
+	}
+	var got []string
+	for _, d := range deliveries {
+		got = append(got, d.Event.ID)
+	}
+	if want := []string{"msg_1", "msg_3"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Deliveries() = %v, want %v", got, want)
+	}
+}
+
+func TestReplay(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1700000000, 0)
+	s := NewMemoryStore(10)
+	_ = s.Record(ctx, testDelivery("msg_1", now.Add(-time.Hour)))
+	_ = s.Record(ctx, testDelivery("msg_2", now))
+
+	var got []string
+	err := Replay(ctx, s, DispatcherFunc(func(_ context.Context, event *Event) error {
+		got = append(got, event.ID)
+		return nil
+	}), time.Time{})
+	if err != nil {
+		t.Errorf("Replay() error = %v", err)
+	}
+
+	if want := []string{"msg_1", "msg_2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Replay() dispatched %v, want %v", got, want)
+	}
+}
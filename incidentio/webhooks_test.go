@@ -0,0 +1,163 @@
+package incidentio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestWebhooksService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/webhooks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Authorization", "Bearer test-key")
+
+		response := `{
+			"webhooks": [
+				{
+					"id": "01FCNDV6P870EA6S7TK1DSYDG0",
+					"name": "Staging receiver",
+					"endpoint": "https://staging.example.com/incident-io/webhooks",
+					"private_incidents": true,
+					"event_types": ["public_incident.incident_created_v2", "private_incident.incident_created_v2"],
+					"created_at": "2021-08-17T13:28:57.801578Z",
+					"updated_at": "2021-08-17T13:28:57.801578Z"
+				}
+			]
+		}`
+
+		_, _ = fmt.Fprint(w, response)
+	})
+
+	ctx := context.Background()
+	webhooks, _, err := client.Webhooks.List(ctx)
+	if err != nil {
+		t.Errorf("Webhooks.List returned error: %v", err)
+	}
+
+	expected := []*Webhook{
+		{
+			ID:               "01FCNDV6P870EA6S7TK1DSYDG0",
+			Name:             "Staging receiver",
+			Endpoint:         "https://staging.example.com/incident-io/webhooks",
+			PrivateIncidents: true,
+			EventTypes:       []string{"public_incident.incident_created_v2", "private_incident.incident_created_v2"},
+			CreatedAt:        Timestamp{parseTime("2021-08-17T13:28:57.801578Z")},
+			UpdatedAt:        Timestamp{parseTime("2021-08-17T13:28:57.801578Z")},
+		},
+	}
+
+	if !reflect.DeepEqual(webhooks, expected) {
+		t.Errorf("Webhooks.List returned %+v, want %+v", webhooks, expected)
+	}
+}
+
+func TestWebhooksService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CreateWebhookOptions{
+		Name:       "Deployment pr-1234",
+		Endpoint:   "https://pr-1234.example.com/incident-io/webhooks",
+		EventTypes: []string{"public_incident.incident_created_v2"},
+	}
+
+	mux.HandleFunc("/v2/webhooks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", "application/json")
+
+		var received CreateWebhookOptions
+		err := json.NewDecoder(r.Body).Decode(&received)
+		if err != nil {
+			t.Errorf("error decoding request body: %v", err)
+		}
+
+		if !reflect.DeepEqual(received, *input) {
+			t.Errorf("Request body = %+v, want %+v", received, *input)
+		}
+
+		response := `{
+			"webhook": {
+				"id": "01FCNDV6P870EA6S7TK1DSYDG0",
+				"name": "Deployment pr-1234",
+				"endpoint": "https://pr-1234.example.com/incident-io/webhooks",
+				"private_incidents": false,
+				"event_types": ["public_incident.incident_created_v2"],
+				"created_at": "2021-08-17T13:28:57.801578Z",
+				"updated_at": "2021-08-17T13:28:57.801578Z"
+			}
+		}`
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, response)
+	})
+
+	ctx := context.Background()
+	webhook, _, err := client.Webhooks.Create(ctx, input)
+	if err != nil {
+		t.Errorf("Webhooks.Create returned error: %v", err)
+	}
+
+	if webhook.ID != "01FCNDV6P870EA6S7TK1DSYDG0" {
+		t.Errorf("Webhooks.Create returned ID %s, want %s", webhook.ID, "01FCNDV6P870EA6S7TK1DSYDG0")
+	}
+}
+
+func TestWebhooksService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/webhooks/01FCNDV6P870EA6S7TK1DSYDG0", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	resp, err := client.Webhooks.Delete(ctx, "01FCNDV6P870EA6S7TK1DSYDG0")
+	if err != nil {
+		t.Errorf("Webhooks.Delete returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Webhooks.Delete returned status %d, want %d", resp.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestWebhooksService_Secrets(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/webhooks/01FCNDV6P870EA6S7TK1DSYDG0/secret", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = fmt.Fprint(w, `{"secret": {"secret": "whsec_old", "created_at": "2021-08-17T13:28:57.801578Z"}}`)
+	})
+
+	mux.HandleFunc("/v2/webhooks/01FCNDV6P870EA6S7TK1DSYDG0/actions/rotate_secret", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		_, _ = fmt.Fprint(w, `{"secret": {"secret": "whsec_new", "created_at": "2021-08-18T13:28:57.801578Z"}}`)
+	})
+
+	ctx := context.Background()
+	secret, _, err := client.Webhooks.GetSecret(ctx, "01FCNDV6P870EA6S7TK1DSYDG0")
+	if err != nil {
+		t.Errorf("Webhooks.GetSecret returned error: %v", err)
+	}
+
+	if secret.Secret != "whsec_old" {
+		t.Errorf("Webhooks.GetSecret returned %s, want %s", secret.Secret, "whsec_old")
+	}
+
+	secret, _, err = client.Webhooks.RotateSecret(ctx, "01FCNDV6P870EA6S7TK1DSYDG0")
+	if err != nil {
+		t.Errorf("Webhooks.RotateSecret returned error: %v", err)
+	}
+
+	if secret.Secret != "whsec_new" {
+		t.Errorf("Webhooks.RotateSecret returned %s, want %s", secret.Secret, "whsec_new")
+	}
+}
@@ -1,6 +1,352 @@
 package incidentio
 
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Workflow triggers.
+const (
+	WorkflowTriggerIncidentCreated       = "incident.created"
+	WorkflowTriggerIncidentUpdated       = "incident.updated"
+	WorkflowTriggerIncidentStatusChanged = "incident.status_changed"
+	WorkflowTriggerManual                = "manual"
+)
+
+// Workflow states.
+const (
+	WorkflowStateActive   = "active"
+	WorkflowStateDisabled = "disabled"
+	WorkflowStateDraft    = "draft"
+	WorkflowStateError    = "error"
+)
+
 // WorkflowsService handles communication with the workflows related methods.
 type WorkflowsService struct {
 	client *Client
 }
+
+// Workflow represents a workflow in Incident.io.
+type Workflow struct {
+	ID                      string            `json:"id"`
+	Name                    string            `json:"name"`
+	Trigger                 *WorkflowTrigger  `json:"trigger"`
+	Version                 int               `json:"version"`
+	Expressions             []Expression      `json:"expressions,omitempty"`
+	ConditionGroups         []ConditionGroup  `json:"condition_groups,omitempty"`
+	Steps                   []WorkflowStep    `json:"steps,omitempty"`
+	OnceFor                 []EngineReference `json:"once_for,omitempty"`
+	IncludePrivateIncidents bool              `json:"include_private_incidents"`
+	ContinueOnStepError     bool              `json:"continue_on_step_error"`
+	Delay                   *WorkflowDelay    `json:"delay,omitempty"`
+	Folder                  string            `json:"folder,omitempty"`
+	RunsOnIncidents         string            `json:"runs_on_incidents"`
+	RunsOnIncidentModes     []string          `json:"runs_on_incident_modes,omitempty"`
+	State                   string            `json:"state"`
+}
+
+// WorkflowTrigger represents the event that starts a workflow.
+type WorkflowTrigger struct {
+	Name  string `json:"name"`
+	Label string `json:"label"`
+}
+
+// WorkflowDelay represents how long a workflow waits before running its steps.
+type WorkflowDelay struct {
+	ForSeconds               int  `json:"for_seconds"`
+	ConditionsApplyOverDelay bool `json:"conditions_apply_over_delay"`
+}
+
+// WorkflowStep represents a single step executed by a workflow.
+type WorkflowStep struct {
+	ID            string         `json:"id"`
+	Name          string         `json:"name"`
+	Label         string         `json:"label"`
+	ParamBindings []ParamBinding `json:"param_bindings"`
+	ForEach       string         `json:"for_each,omitempty"`
+}
+
+// EngineReference represents a reference to a value in the workflow engine scope.
+type EngineReference struct {
+	Key   string `json:"key"`
+	Label string `json:"label"`
+	Type  string `json:"type"`
+	Array bool   `json:"array"`
+}
+
+// ConditionGroup represents a set of conditions that must all hold. A list of
+// condition groups matches when any one of the groups matches.
+type ConditionGroup struct {
+	Conditions []Condition `json:"conditions"`
+}
+
+// Condition represents a single condition within a condition group.
+type Condition struct {
+	Subject       *ConditionSubject   `json:"subject"`
+	Operation     *ConditionOperation `json:"operation"`
+	ParamBindings []ParamBinding      `json:"param_bindings"`
+}
+
+// ConditionSubject represents the value a condition is evaluated against.
+type ConditionSubject struct {
+	Label     string `json:"label"`
+	Reference string `json:"reference"`
+}
+
+// ConditionOperation represents the comparison applied by a condition.
+type ConditionOperation struct {
+	Label string `json:"label"`
+	Value string `json:"value"`
+}
+
+// ParamBinding represents a value bound to a parameter, either as a single
+// value or an array of values.
+type ParamBinding struct {
+	Value      *ParamBindingValue  `json:"value,omitempty"`
+	ArrayValue []ParamBindingValue `json:"array_value,omitempty"`
+}
+
+// ParamBindingValue represents either a literal value or a reference to a
+// value in scope.
+type ParamBindingValue struct {
+	Label     string `json:"label,omitempty"`
+	Literal   string `json:"literal,omitempty"`
+	Reference string `json:"reference,omitempty"`
+}
+
+// Expression represents a value derived from the workflow scope through a
+// series of operations.
+type Expression struct {
+	ID            string                `json:"id"`
+	Label         string                `json:"label"`
+	Reference     string                `json:"reference"`
+	RootReference string                `json:"root_reference"`
+	Operations    []ExpressionOperation `json:"operations"`
+	ElseBranch    *ExpressionElseBranch `json:"else_branch,omitempty"`
+}
+
+// ExpressionOperation represents a single operation within an expression.
+// Exactly one of the operation specific fields is set, matching OperationType.
+type ExpressionOperation struct {
+	OperationType string                       `json:"operation_type"`
+	Navigate      *ExpressionNavigateOperation `json:"navigate,omitempty"`
+	Filter        *ExpressionFilterOperation   `json:"filter,omitempty"`
+	Parse         *ExpressionParseOperation    `json:"parse,omitempty"`
+	Returns       *ExpressionOperationReturns  `json:"returns,omitempty"`
+}
+
+// ExpressionNavigateOperation navigates to an attribute of the current value.
+type ExpressionNavigateOperation struct {
+	Reference      string `json:"reference"`
+	ReferenceLabel string `json:"reference_label,omitempty"`
+}
+
+// ExpressionFilterOperation keeps the values matching the condition groups.
+type ExpressionFilterOperation struct {
+	ConditionGroups []ConditionGroup `json:"condition_groups"`
+}
+
+// ExpressionParseOperation evaluates a JavaScript source against the current value.
+type ExpressionParseOperation struct {
+	Source  string                      `json:"source"`
+	Returns *ExpressionOperationReturns `json:"returns"`
+}
+
+// ExpressionOperationReturns describes the type produced by an operation.
+type ExpressionOperationReturns struct {
+	Type  string `json:"type"`
+	Array bool   `json:"array"`
+}
+
+// ExpressionElseBranch represents the value used when an expression produces no result.
+type ExpressionElseBranch struct {
+	Result *ParamBinding `json:"result"`
+}
+
+// ConditionGroupPayload represents a condition group when creating or updating a workflow.
+type ConditionGroupPayload struct {
+	Conditions []ConditionPayload `json:"conditions"`
+}
+
+// ConditionPayload represents a condition when creating or updating a workflow.
+type ConditionPayload struct {
+	Subject       string         `json:"subject"`
+	Operation     string         `json:"operation"`
+	ParamBindings []ParamBinding `json:"param_bindings"`
+}
+
+// ExpressionPayload represents an expression when creating or updating a workflow.
+type ExpressionPayload struct {
+	Label         string                       `json:"label"`
+	Reference     string                       `json:"reference"`
+	RootReference string                       `json:"root_reference"`
+	Operations    []ExpressionOperationPayload `json:"operations"`
+	ElseBranch    *ExpressionElseBranch        `json:"else_branch,omitempty"`
+}
+
+// ExpressionOperationPayload represents an expression operation when creating
+// or updating a workflow.
+type ExpressionOperationPayload struct {
+	OperationType string                            `json:"operation_type"`
+	Navigate      *ExpressionNavigateOperation      `json:"navigate,omitempty"`
+	Filter        *ExpressionFilterOperationPayload `json:"filter,omitempty"`
+	Parse         *ExpressionParseOperation         `json:"parse,omitempty"`
+}
+
+// ExpressionFilterOperationPayload represents a filter operation when creating
+// or updating a workflow.
+type ExpressionFilterOperationPayload struct {
+	ConditionGroups []ConditionGroupPayload `json:"condition_groups"`
+}
+
+// WorkflowStepPayload represents a step when creating or updating a workflow.
+type WorkflowStepPayload struct {
+	ID            string         `json:"id,omitempty"`
+	Name          string         `json:"name"`
+	ParamBindings []ParamBinding `json:"param_bindings"`
+	ForEach       string         `json:"for_each,omitempty"`
+}
+
+// CreateWorkflowOptions represents options for creating a workflow.
+type CreateWorkflowOptions struct {
+	Trigger                 string                  `json:"trigger"`
+	Name                    string                  `json:"name"`
+	OnceFor                 []string                `json:"once_for"`
+	ConditionGroups         []ConditionGroupPayload `json:"condition_groups"`
+	Steps                   []WorkflowStepPayload   `json:"steps"`
+	Expressions             []ExpressionPayload     `json:"expressions"`
+	IncludePrivateIncidents bool                    `json:"include_private_incidents"`
+	ContinueOnStepError     bool                    `json:"continue_on_step_error"`
+	Delay                   *WorkflowDelay          `json:"delay,omitempty"`
+	Folder                  string                  `json:"folder,omitempty"`
+	RunsOnIncidents         string                  `json:"runs_on_incidents"`
+	RunsOnIncidentModes     []string                `json:"runs_on_incident_modes"`
+	State                   string                  `json:"state,omitempty"`
+}
+
+// UpdateWorkflowOptions represents options for updating a workflow. The
+// workflow definition is replaced as a whole, so every field must be set.
+type UpdateWorkflowOptions struct {
+	Name                    string                  `json:"name"`
+	OnceFor                 []string                `json:"once_for"`
+	ConditionGroups         []ConditionGroupPayload `json:"condition_groups"`
+	Steps                   []WorkflowStepPayload   `json:"steps"`
+	Expressions             []ExpressionPayload     `json:"expressions"`
+	IncludePrivateIncidents bool                    `json:"include_private_incidents"`
+	ContinueOnStepError     bool                    `json:"continue_on_step_error"`
+	Delay                   *WorkflowDelay          `json:"delay,omitempty"`
+	Folder                  string                  `json:"folder,omitempty"`
+	RunsOnIncidents         string                  `json:"runs_on_incidents"`
+	RunsOnIncidentModes     []string                `json:"runs_on_incident_modes"`
+	State                   string                  `json:"state,omitempty"`
+}
+
+// InvokeWorkflowOptions represents options for manually invoking a workflow.
+type InvokeWorkflowOptions struct {
+	IncidentID string `json:"incident_id"`
+}
+
+// List returns a list of workflows.
+func (s *WorkflowsService) List(ctx context.Context) ([]*Workflow, *http.Response, error) {
+	u := "v2/workflows"
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		Workflows []*Workflow `json:"workflows"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.Workflows, resp, nil
+}
+
+// Get returns a single workflow.
+func (s *WorkflowsService) Get(ctx context.Context, id string) (*Workflow, *http.Response, error) {
+	u := fmt.Sprintf("v2/workflows/%s", id)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		Workflow *Workflow `json:"workflow"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.Workflow, resp, nil
+}
+
+// Create creates a new workflow.
+func (s *WorkflowsService) Create(ctx context.Context, opts *CreateWorkflowOptions) (*Workflow, *http.Response, error) {
+	u := "v2/workflows"
+
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		Workflow *Workflow `json:"workflow"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.Workflow, resp, nil
+}
+
+// Update updates a workflow.
+func (s *WorkflowsService) Update(ctx context.Context, id string, opts *UpdateWorkflowOptions) (*Workflow, *http.Response, error) {
+	u := fmt.Sprintf("v2/workflows/%s", id)
+
+	req, err := s.client.NewRequest("PUT", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result struct {
+		Workflow *Workflow `json:"workflow"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.Workflow, resp, nil
+}
+
+// Delete deletes a workflow.
+func (s *WorkflowsService) Delete(ctx context.Context, id string) (*http.Response, error) {
+	u := fmt.Sprintf("v2/workflows/%s", id)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// Invoke manually runs a workflow against an incident.
+func (s *WorkflowsService) Invoke(ctx context.Context, id string, opts *InvokeWorkflowOptions) (*http.Response, error) {
+	u := fmt.Sprintf("v2/workflows/%s/actions/invoke", id)
+
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,281 @@
+package incidentio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestWorkflowsService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/workflows", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Authorization", "Bearer test-key")
+
+		response := `{
+			"workflows": [
+				{
+					"id": "01FCNDV6P870EA6S7TK1DSYDG0",
+					"name": "Page the database team",
+					"trigger": {"name": "incident.updated", "label": "Incident updated"},
+					"version": 3,
+					"condition_groups": [
+						{
+							"conditions": [
+								{
+									"subject": {"label": "Incident → Severity", "reference": "incident.severity"},
+									"operation": {"label": "is one of", "value": "one_of"},
+									"param_bindings": [
+										{"array_value": [{"label": "Critical", "literal": "01FH5TZRWMNAFB0DZ23FD1V96N"}]}
+									]
+								}
+							]
+						}
+					],
+					"steps": [
+						{
+							"id": "01FCNDV6P870EA6S7TK1DSYDG1",
+							"name": "pagerduty.escalate",
+							"label": "Escalate to PagerDuty",
+							"param_bindings": [{"value": {"literal": "PDB123"}}]
+						}
+					],
+					"once_for": [{"key": "incident", "label": "Incident", "type": "IncidentEngine", "array": false}],
+					"include_private_incidents": false,
+					"continue_on_step_error": true,
+					"runs_on_incidents": "newly_created",
+					"runs_on_incident_modes": ["standard"],
+					"state": "active"
+				}
+			]
+		}`
+
+		_, _ = fmt.Fprint(w, response)
+	})
+
+	ctx := context.Background()
+	workflows, _, err := client.Workflows.List(ctx)
+	if err != nil {
+		t.Errorf("Workflows.List returned error: %v", err)
+	}
+
+	expected := []*Workflow{
+		{
+			ID:      "01FCNDV6P870EA6S7TK1DSYDG0",
+			Name:    "Page the database team",
+			Trigger: &WorkflowTrigger{Name: WorkflowTriggerIncidentUpdated, Label: "Incident updated"},
+			Version: 3,
+			ConditionGroups: []ConditionGroup{
+				{
+					Conditions: []Condition{
+						{
+							Subject:   &ConditionSubject{Label: "Incident → Severity", Reference: "incident.severity"},
+							Operation: &ConditionOperation{Label: "is one of", Value: "one_of"},
+							ParamBindings: []ParamBinding{
+								{ArrayValue: []ParamBindingValue{{Label: "Critical", Literal: "01FH5TZRWMNAFB0DZ23FD1V96N"}}},
+							},
+						},
+					},
+				},
+			},
+			Steps: []WorkflowStep{
+				{
+					ID:            "01FCNDV6P870EA6S7TK1DSYDG1",
+					Name:          "pagerduty.escalate",
+					Label:         "Escalate to PagerDuty",
+					ParamBindings: []ParamBinding{{Value: &ParamBindingValue{Literal: "PDB123"}}},
+				},
+			},
+			OnceFor:             []EngineReference{{Key: "incident", Label: "Incident", Type: "IncidentEngine"}},
+			ContinueOnStepError: true,
+			RunsOnIncidents:     "newly_created",
+			RunsOnIncidentModes: []string{"standard"},
+			State:               WorkflowStateActive,
+		},
+	}
+
+	if !reflect.DeepEqual(workflows, expected) {
+		t.Errorf("Workflows.List returned %+v, want %+v", workflows, expected)
+	}
+}
+
+func TestWorkflowsService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &CreateWorkflowOptions{
+		Trigger: WorkflowTriggerIncidentCreated,
+		Name:    "Notify #eng",
+		OnceFor: []string{"incident"},
+		ConditionGroups: []ConditionGroupPayload{
+			{
+				Conditions: []ConditionPayload{
+					{
+						Subject:   "incident.severity",
+						Operation: "one_of",
+						ParamBindings: []ParamBinding{
+							{ArrayValue: []ParamBindingValue{{Literal: "01FH5TZRWMNAFB0DZ23FD1V96N"}}},
+						},
+					},
+				},
+			},
+		},
+		Steps: []WorkflowStepPayload{
+			{
+				Name:          "slack.post_message",
+				ParamBindings: []ParamBinding{{Value: &ParamBindingValue{Literal: "C02AW36C1M5"}}},
+			},
+		},
+		Expressions: []ExpressionPayload{
+			{
+				Label:         "Affected team",
+				Reference:     "affected-team",
+				RootReference: "incident.custom_field",
+				Operations: []ExpressionOperationPayload{
+					{
+						OperationType: "navigate",
+						Navigate:      &ExpressionNavigateOperation{Reference: "team"},
+					},
+				},
+			},
+		},
+		RunsOnIncidents:     "newly_created",
+		RunsOnIncidentModes: []string{"standard"},
+		State:               WorkflowStateDraft,
+	}
+
+	mux.HandleFunc("/v2/workflows", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", "application/json")
+
+		var received CreateWorkflowOptions
+		err := json.NewDecoder(r.Body).Decode(&received)
+		if err != nil {
+			t.Errorf("error decoding request body: %v", err)
+		}
+
+		if !reflect.DeepEqual(received, *input) {
+			t.Errorf("Request body = %+v, want %+v", received, *input)
+		}
+
+		response := `{
+			"workflow": {
+				"id": "01FCNDV6P870EA6S7TK1DSYDG0",
+				"name": "Notify #eng",
+				"trigger": {"name": "incident.created", "label": "Incident created"},
+				"version": 1,
+				"runs_on_incidents": "newly_created",
+				"state": "draft"
+			}
+		}`
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, response)
+	})
+
+	ctx := context.Background()
+	workflow, resp, err := client.Workflows.Create(ctx, input)
+	if err != nil {
+		t.Errorf("Workflows.Create returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Errorf("Workflows.Create returned status %d, want %d", resp.StatusCode, http.StatusCreated)
+	}
+
+	if workflow.State != WorkflowStateDraft {
+		t.Errorf("Workflows.Create returned State %s, want %s", workflow.State, WorkflowStateDraft)
+	}
+}
+
+func TestWorkflowsService_Update(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/workflows/01FCNDV6P870EA6S7TK1DSYDG0", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+
+		var received UpdateWorkflowOptions
+		err := json.NewDecoder(r.Body).Decode(&received)
+		if err != nil {
+			t.Errorf("error decoding request body: %v", err)
+		}
+
+		if received.State != WorkflowStateDisabled {
+			t.Errorf("Request state = %s, want %s", received.State, WorkflowStateDisabled)
+		}
+
+		_, _ = fmt.Fprint(w, `{"workflow": {"id": "01FCNDV6P870EA6S7TK1DSYDG0", "name": "Notify #eng", "version": 2, "state": "disabled"}}`)
+	})
+
+	ctx := context.Background()
+	workflow, _, err := client.Workflows.Update(ctx, "01FCNDV6P870EA6S7TK1DSYDG0", &UpdateWorkflowOptions{
+		Name:  "Notify #eng",
+		State: WorkflowStateDisabled,
+	})
+	if err != nil {
+		t.Errorf("Workflows.Update returned error: %v", err)
+	}
+
+	if workflow.Version != 2 {
+		t.Errorf("Workflows.Update returned Version %d, want %d", workflow.Version, 2)
+	}
+}
+
+func TestWorkflowsService_Delete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/workflows/01FCNDV6P870EA6S7TK1DSYDG0", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	resp, err := client.Workflows.Delete(ctx, "01FCNDV6P870EA6S7TK1DSYDG0")
+	if err != nil {
+		t.Errorf("Workflows.Delete returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Workflows.Delete returned status %d, want %d", resp.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestWorkflowsService_Invoke(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/workflows/01FCNDV6P870EA6S7TK1DSYDG0/actions/invoke", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		var received InvokeWorkflowOptions
+		err := json.NewDecoder(r.Body).Decode(&received)
+		if err != nil {
+			t.Errorf("error decoding request body: %v", err)
+		}
+
+		if received.IncidentID != "01FDAG4SAP5TYPT98WGR2N7W91" {
+			t.Errorf("Request incident_id = %s, want %s", received.IncidentID, "01FDAG4SAP5TYPT98WGR2N7W91")
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	ctx := context.Background()
+	resp, err := client.Workflows.Invoke(ctx, "01FCNDV6P870EA6S7TK1DSYDG0", &InvokeWorkflowOptions{
+		IncidentID: "01FDAG4SAP5TYPT98WGR2N7W91",
+	})
+	if err != nil {
+		t.Errorf("Workflows.Invoke returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusAccepted {
+		t.Errorf("Workflows.Invoke returned status %d, want %d", resp.StatusCode, http.StatusAccepted)
+	}
+}